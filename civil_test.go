@@ -0,0 +1,125 @@
+package godantic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCivil(t *testing.T) {
+	d, err := ParseDate("2024-02-29")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2024, Month: time.February, Day: 29}, d)
+	assert.Equal(t, "2024-02-29", d.String())
+
+	for _, input := range []string{"2023-02-31", "2023-02-29", "2023-13-01", "2023-1-01", ""} {
+		_, err := ParseDate(input)
+		assert.Error(t, err, input)
+	}
+
+	tod, err := ParseTimeOfDay("08:30")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeOfDay{Hour: 8, Minute: 30}, tod)
+	tod, err = ParseTimeOfDay("17:45:10.5")
+	assert.NoError(t, err)
+	assert.Equal(t, "17:45:10.5", tod.String())
+
+	for _, input := range []string{"24:00", "12:60", "8:30am", ""} {
+		_, err := ParseTimeOfDay(input)
+		assert.Error(t, err, input)
+	}
+
+	assert.True(t, Date{2024, 1, 31}.Before(Date{2024, 2, 1}))
+	assert.True(t, TimeOfDay{Hour: 18}.After(TimeOfDay{Hour: 9, Minute: 59}))
+}
+
+func TestCivilJSON(t *testing.T) {
+	type Shift struct {
+		Day    Date      `json:"day"`
+		Starts TimeOfDay `json:"starts"`
+		Length Duration  `json:"length"`
+	}
+	in := Shift{
+		Day:    Date{2024, time.March, 4},
+		Starts: TimeOfDay{Hour: 9},
+		Length: Duration(8*time.Hour + 30*time.Minute),
+	}
+	data, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"day": "2024-03-04", "starts": "09:00:00", "length": "8h30m0s"}`, string(data))
+
+	var out Shift
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestCivilBinding(t *testing.T) {
+	g := &Validate{}
+
+	type OpeningHours struct {
+		Since    Date       `json:"since" binding:"required" min:"2000-01-01"`
+		Holiday  *Date      `json:"holiday" lt:"2030-01-01"`
+		Opens    *TimeOfDay `json:"opens" ge:"06:00"`
+		Closes   *TimeOfDay `json:"closes" le:"23:00"`
+		Break    *Duration  `json:"break" min:"15m" max:"1h"`
+		Holidays []Date     `json:"holidays"`
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		var h OpeningHours
+		err := g.BindJSON([]byte(`{
+			"since": "2012-05-01",
+			"holiday": "2024-12-25",
+			"opens": "08:00",
+			"closes": "18:30",
+			"break": "45m",
+			"holidays": ["2024-01-01", "2024-06-25"]
+		}`), &h)
+		assert.NoError(t, err)
+		assert.Equal(t, Date{2012, time.May, 1}, h.Since)
+		assert.Equal(t, TimeOfDay{Hour: 18, Minute: 30}, *h.Closes)
+		assert.Equal(t, Duration(45*time.Minute), *h.Break)
+		assert.Len(t, h.Holidays, 2)
+	})
+
+	testCases := []struct {
+		name    string
+		data    string
+		errType string
+		path    string
+	}{
+		{"Missing required date", `{"opens": "08:00"}`, "REQUIRED_FIELD_ERR", "since"},
+		{"Nonexistent date", `{"since": "2023-02-31"}`, "INVALID_DATE_ERR", "since"},
+		{"Nonexistent date in a list", `{"since": "2012-05-01", "holidays": ["2023-02-30"]}`, "INVALID_DATE_ERR", "holidays[0]"},
+		{"Date as a number", `{"since": 20120501}`, "INVALID_DATE_ERR", "since"},
+		{"Invalid time of day", `{"since": "2012-05-01", "opens": "25:00"}`, "INVALID_TIME_OF_DAY_ERR", "opens"},
+		{"Invalid duration", `{"since": "2012-05-01", "break": "an hour"}`, "INVALID_DURATION_ERR", "break"},
+		{"Date below min", `{"since": "1999-12-31"}`, "MIN_VALUE_ERR", "since"},
+		{"Date not below lt", `{"since": "2012-05-01", "holiday": "2030-01-01"}`, "LESS_THAN_ERR", "holiday"},
+		{"Opens too early", `{"since": "2012-05-01", "opens": "05:59"}`, "GREATER_EQUAL_ERR", "opens"},
+		{"Closes too late", `{"since": "2012-05-01", "closes": "23:00:01"}`, "LESS_EQUAL_ERR", "closes"},
+		{"Break too short", `{"since": "2012-05-01", "break": "10m"}`, "MIN_VALUE_ERR", "break"},
+		{"Break too long", `{"since": "2012-05-01", "break": "1h1m"}`, "MAX_VALUE_ERR", "break"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var h OpeningHours
+			err := g.BindJSON([]byte(tc.data), &h)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+
+	t.Run("Malformed bound", func(t *testing.T) {
+		type BadBound struct {
+			Since *Date `json:"since" min:"yesterday"`
+		}
+		err := g.InspectStruct(BadBound{})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "since", err.(*Error).Path)
+	})
+}
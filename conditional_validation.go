@@ -32,7 +32,7 @@ func extractEnumValues(rootVal reflect.Value, parentPath string) map[string]stri
 
 		t := v.Type()
 		for i := 0; i < t.NumField(); i++ {
-			field := t.Field(i)
+			field := structField(t, i)
 			fieldValue := deref(v.Field(i))
 
 			// Extrair o nome da chave JSON
@@ -138,7 +138,7 @@ func (g *Validate) validateCondition(f reflect.StructField, valField reflect.Val
 		if bindingType, hasBinding := bindings["binding"]; hasBinding {
 			switch bindingType {
 			case "required":
-				if valField.IsZero() {
+				if g.isMissing(f, valField, fullPath) {
 					return &Error{
 						ErrType: "REQUIRED_FIELD_ERR",
 						Path:    fName,
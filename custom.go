@@ -3,29 +3,93 @@ package godantic
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 )
 
-type customValidatorFunc func(value any, path string) *Error
+type customValidatorFunc func(value any, args Args, path string) *Error
 
-var (
-	customValidators   = make(map[reflect.Type]map[string]customValidatorFunc)
-	customValidatorMux sync.RWMutex
+type customValidator struct {
+	fn customValidatorFunc
+	// takesArgs is false for validators registered with RegisterCustom;
+	// params, when set, are the argument types they are checked against.
+	takesArgs bool
+	params    []ArgType
+}
+
+var parsedCustomTags sync.Map
+
+// Args holds the arguments given to a custom validator in the tag, e.g.
+// `validate:"between(1,10)"` yields ["1", "10"]. The typed accessors expect
+// arguments checked against the ArgTypes the validator was registered with.
+type Args []string
+
+// ArgType is the type of a custom validator argument, checked once per
+// struct type and reported as INVALID_CONFIG_ERR.
+type ArgType int
+
+const (
+	ArgString ArgType = iota
+	ArgInt
+	ArgFloat
+	ArgDecimal
+	// ArgList is a list of strings separated by |, e.g. one_of_ci(a|b).
+	ArgList
 )
 
+func (t ArgType) String() string {
+	return [...]string{"string", "int", "float", "decimal", "list"}[t]
+}
+
+func (t ArgType) check(arg string) error {
+	var err error
+	switch t {
+	case ArgInt:
+		_, err = strconv.ParseInt(arg, 10, 64)
+	case ArgFloat:
+		_, err = strconv.ParseFloat(arg, 64)
+	case ArgDecimal:
+		_, err = ParseDecimal(arg)
+	}
+	return err
+}
+
+func (a Args) Int(i int) int64 {
+	n, _ := strconv.ParseInt(a[i], 10, 64)
+	return n
+}
+
+func (a Args) Float(i int) float64 {
+	f, _ := strconv.ParseFloat(a[i], 64)
+	return f
+}
+
+func (a Args) Decimal(i int) Decimal {
+	d, _ := ParseDecimal(a[i])
+	return d
+}
+
+func (a Args) List(i int) []string {
+	return strings.Split(a[i], "|")
+}
+
 func RegisterCustom[T any](tag string, fn func(T, string) *Error) {
-	customValidatorMux.Lock()
-	defer customValidatorMux.Unlock()
+	RegisterCustomIn[T](defaultRegistry, tag, fn)
+}
 
+// RegisterCustomWithArgs registers a custom validator that takes arguments
+// in the tag, e.g. `validate:"between(1,10)"`. When params are given, the
+// arguments must match them in number and type.
+func RegisterCustomWithArgs[T any](name string, fn func(v T, args Args, path string) *Error, params ...ArgType) {
+	RegisterCustomWithArgsIn[T](defaultRegistry, name, fn, params...)
+}
+
+func registerCustom[T any](r *Registry, tag string, validator customValidator, fn func(T, Args, string) *Error) {
 	var zero T
 	t := reflect.TypeOf(zero)
 
-	if customValidators[t] == nil {
-		customValidators[t] = make(map[string]customValidatorFunc)
-	}
-
-	customValidators[t][tag] = func(value any, path string) *Error {
+	validator.fn = func(value any, args Args, path string) *Error {
 		v, ok := value.(T)
 		if !ok {
 			return &Error{
@@ -34,19 +98,97 @@ func RegisterCustom[T any](tag string, fn func(T, string) *Error) {
 				Message: fmt.Sprintf("Expected type %T but got %T", zero, value),
 			}
 		}
-		return fn(v, path)
+		return fn(v, args, path)
+	}
+
+	r.mu.Lock()
+	if r.custom[t] == nil {
+		r.custom[t] = make(map[string]customValidator)
+	}
+	r.custom[t][tag] = validator
+	r.mu.Unlock()
+	resetTypeConfigs()
+}
+
+type customTag struct {
+	name string
+	args Args
+}
+
+// parseCustomTags splits a `validate` tag into validator names and their
+// arguments. Commas inside parentheses separate arguments.
+func parseCustomTags(tag string) ([]customTag, error) {
+	if cached, ok := parsedCustomTags.Load(tag); ok {
+		return cached.([]customTag), nil
+	}
+	var tags []customTag
+	depth, start := 0, 0
+	for i := 0; i <= len(tag); i++ {
+		if i < len(tag) {
+			switch tag[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if tag[i] != ',' || depth > 0 {
+				continue
+			}
+		}
+		part := strings.TrimSpace(tag[start:i])
+		start = i + 1
+		if part == "" {
+			continue
+		}
+		name, args, err := parseFormatTag(part)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, customTag{name: name, args: args})
 	}
+	parsedCustomTags.Store(tag, tags)
+	return tags, nil
 }
 
-func getCustomValidator(t reflect.Type, tag string) (customValidatorFunc, bool) {
-	customValidatorMux.RLock()
-	defer customValidatorMux.RUnlock()
+func customFieldType(f reflect.StructField) reflect.Type {
+	t := f.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
 
-	if m, ok := customValidators[t]; ok {
-		fn, exists := m[tag]
-		return fn, exists
+// checkCustomConfig reports `validate` tags naming validators that aren't
+// registered for the field's type, and arguments that don't match them.
+func checkCustomConfig(r *Registry, f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("validate")
+	if !ok {
+		return ""
 	}
-	return nil, false
+	tags, err := parseCustomTags(tag)
+	if err != nil {
+		return fmt.Sprintf("validate: %v", err)
+	}
+	t := customFieldType(f)
+	for _, ct := range tags {
+		validator, ok := r.customValidator(t, ct.name)
+		switch {
+		case !ok:
+			return fmt.Sprintf("validate: no validator '%s' is registered for %s", ct.name, t)
+		case !validator.takesArgs && len(ct.args) > 0:
+			return fmt.Sprintf("validate: '%s' takes no arguments", ct.name)
+		case validator.params == nil:
+			continue
+		case len(ct.args) != len(validator.params):
+			return fmt.Sprintf("validate: '%s' takes %d arguments, %d given", ct.name, len(validator.params), len(ct.args))
+		}
+		for i, param := range validator.params {
+			if err := param.check(ct.args[i]); err != nil {
+				return fmt.Sprintf("validate: '%s' argument %d: '%s' is not a valid %s", ct.name, i+1, ct.args[i], param)
+			}
+		}
+	}
+	return ""
 }
 
 func (g *Validate) validateWithCustomTag(val any, f reflect.StructField, path string) *Error {
@@ -54,21 +196,25 @@ func (g *Validate) validateWithCustomTag(val any, f reflect.StructField, path st
 	if tag == "" {
 		return nil
 	}
-
-	t := f.Type
-	if t.Kind() == reflect.Ptr {
-		t = t.Elem()
+	tags, err := parseCustomTags(tag)
+	if err != nil {
+		return nil // reported by the type configuration check
 	}
 
-	tags := strings.Split(tag, ",")
-	for _, singleTag := range tags {
-		singleTag = strings.TrimSpace(singleTag)
-		if singleTag == "" {
-			continue
+	v := reflect.ValueOf(val)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
 		}
+		v = v.Elem()
+		val = v.Interface()
+	}
 
-		if fn, ok := getCustomValidator(t, singleTag); ok {
-			err := fn(val, path)
+	t := customFieldType(f)
+	r := g.registry()
+	for _, ct := range tags {
+		if validator, ok := r.customValidator(t, ct.name); ok {
+			err := validator.fn(val, ct.args, path)
 			if err != nil {
 				if err.Path == "" {
 					err.Path = path
@@ -1,7 +1,9 @@
 package godantic
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -69,3 +71,92 @@ func TestValidateWithCustomTag(t *testing.T) {
 		assert.Equal(t, "NEGATIVE_VALUE_ERR", err.ErrType)
 	})
 }
+
+func TestCustomValidatorArgs(t *testing.T) {
+	g := &Validate{}
+
+	RegisterCustomWithArgs[int]("between", func(val int, args Args, path string) *Error {
+		if int64(val) < args.Int(0) || int64(val) > args.Int(1) {
+			return &Error{ErrType: "BETWEEN_ERR", Message: fmt.Sprintf("must be between %s and %s", args[0], args[1])}
+		}
+		return nil
+	}, ArgInt, ArgInt)
+	RegisterCustomWithArgs[string]("prefix", func(val string, args Args, path string) *Error {
+		if !strings.HasPrefix(val, args[0]) {
+			return &Error{ErrType: "PREFIX_ERR", Message: "must start with " + args[0]}
+		}
+		return nil
+	}, ArgString)
+	RegisterCustomWithArgs[string]("one_of_ci", func(val string, args Args, path string) *Error {
+		for _, option := range args.List(0) {
+			if strings.EqualFold(val, option) {
+				return nil
+			}
+		}
+		return &Error{ErrType: "ONE_OF_ERR", Message: "must be one of " + args[0]}
+	}, ArgList)
+	RegisterCustom[string]("not_blank", func(val string, path string) *Error {
+		if strings.TrimSpace(val) == "" {
+			return &Error{ErrType: "BLANK_ERR", Message: "must not be blank"}
+		}
+		return nil
+	})
+
+	type Vehicle struct {
+		Seats *int    `json:"seats" validate:"between(1,10)"`
+		Plate *string `json:"plate" validate:"not_blank,prefix(MZ),one_of_ci(MZ-A|MZ-B)"`
+	}
+
+	assert.NoError(t, g.InspectStruct(Vehicle{Seats: toPtr(4), Plate: toPtr("MZ-a")}))
+
+	testCases := []struct {
+		name    string
+		vehicle Vehicle
+		errType string
+		path    string
+	}{
+		{"Out of range", Vehicle{Seats: toPtr(11)}, "BETWEEN_ERR", "seats"},
+		{"Wrong prefix", Vehicle{Plate: toPtr("ZA-A")}, "PREFIX_ERR", "plate"},
+		{"Not an option", Vehicle{Plate: toPtr("MZ-C")}, "ONE_OF_ERR", "plate"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.vehicle)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+
+	configCases := []struct {
+		name  string
+		value any
+	}{
+		{"Unregistered name", struct {
+			Field *string `json:"field" validate:"no_such_rule"`
+		}{}},
+		{"Registered for another type", struct {
+			Field *string `json:"field" validate:"between(1,2)"`
+		}{}},
+		{"Argument of the wrong type", struct {
+			Field *int `json:"field" validate:"between(1,ten)"`
+		}{}},
+		{"Wrong number of arguments", struct {
+			Field *int `json:"field" validate:"between(1)"`
+		}{}},
+		{"Arguments to a plain validator", struct {
+			Field *string `json:"field" validate:"not_blank(x)"`
+		}{}},
+		{"Missing parenthesis", struct {
+			Field *int `json:"field" validate:"between(1,2"`
+		}{}},
+	}
+	for _, tc := range configCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.value)
+			assert.Error(t, err)
+			assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+			assert.Equal(t, "field", err.(*Error).Path)
+		})
+	}
+}
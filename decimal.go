@@ -0,0 +1,196 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number for money and other values that must
+// not go through float64. It binds from JSON numbers and strings, keeps the
+// digits it was given (so "10.50" marshals back as "10.50"), and marshals
+// as a JSON string. The zero Decimal is 0.
+type Decimal struct {
+	s string
+}
+
+// maxDecimalExponent bounds the exponent accepted by ParseDecimal, so a
+// value such as "1e1000000000" can't allocate a billion digits.
+const maxDecimalExponent = 1000
+
+// ParseDecimal parses a decimal number such as "-12.50", ".5" or "1.2e3".
+func ParseDecimal(s string) (Decimal, error) {
+	invalid := fmt.Errorf("'%s' is not a decimal number", s)
+
+	rest := s
+	sign := ""
+	if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+		if rest[0] == '-' {
+			sign = "-"
+		}
+		rest = rest[1:]
+	}
+	exp := 0
+	if i := strings.IndexAny(rest, "eE"); i != -1 {
+		e, err := strconv.Atoi(rest[i+1:])
+		if err != nil || e > maxDecimalExponent || e < -maxDecimalExponent {
+			return Decimal{}, invalid
+		}
+		exp, rest = e, rest[:i]
+	}
+	intPart, fracPart := rest, ""
+	if i := strings.IndexByte(rest, '.'); i != -1 {
+		intPart, fracPart = rest[:i], rest[i+1:]
+	}
+	if (intPart == "" && fracPart == "") || !isDigits(intPart+fracPart) {
+		return Decimal{}, invalid
+	}
+
+	// Move the decimal point by the exponent.
+	digits := intPart + fracPart
+	point := len(intPart) + exp
+	switch {
+	case point < 0:
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	case point > len(digits):
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	intPart, fracPart = strings.TrimLeft(digits[:point], "0"), digits[point:]
+	if intPart == "" {
+		intPart = "0"
+	}
+	if strings.Trim(intPart+fracPart, "0") == "" {
+		sign = ""
+	}
+	if fracPart == "" {
+		return Decimal{s: sign + intPart}, nil
+	}
+	return Decimal{s: sign + intPart + "." + fracPart}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is not a decimal.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimalFromInt returns the Decimal equal to n.
+func NewDecimalFromInt(n int64) Decimal {
+	return Decimal{s: strconv.FormatInt(n, 10)}
+}
+
+func (d Decimal) String() string {
+	if d.s == "" {
+		return "0"
+	}
+	return d.s
+}
+
+// Rat returns d as a big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	r, _ := new(big.Rat).SetString(d.String())
+	return r
+}
+
+// Cmp returns -1, 0 or 1 as d is less than, equal to or greater than other.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Sign returns -1, 0 or 1 as d is negative, zero or positive.
+func (d Decimal) Sign() int {
+	return d.Rat().Sign()
+}
+
+// IsZero reports whether d equals 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// IsMultipleOf reports whether d is an exact multiple of base. Every value
+// is a multiple of 0.
+func (d Decimal) IsMultipleOf(base Decimal) bool {
+	if base.IsZero() {
+		return true
+	}
+	return new(big.Rat).Quo(d.Rat(), base.Rat()).IsInt()
+}
+
+// Digits returns the number of integer digits, without leading zeros, and
+// the number of decimal places, without trailing zeros.
+func (d Decimal) Digits() (integer, places int) {
+	return countDigits(d.String())
+}
+
+// countDigits counts the digits of a plain decimal string the way the
+// max_digits and decimal_places tags do.
+func countDigits(s string) (integer, places int) {
+	s = strings.TrimLeft(s, "-+")
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	return len(strings.TrimLeft(intPart, "0")), len(strings.TrimRight(fracPart, "0"))
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts a JSON number or a string holding one.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+var decimalType = reflect.TypeOf(Decimal{})
+
+// exactValue returns integers and Decimals as Decimal, so their bounds can
+// be compared without converting to float64.
+func exactValue(v reflect.Value) (Decimal, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NewDecimalFromInt(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Decimal{s: strconv.FormatUint(v.Uint(), 10)}, true
+	}
+	if d, ok := v.Interface().(Decimal); ok {
+		return d, true
+	}
+	return Decimal{}, false
+}
+
+func (g *Validate) checkMultipleOf(f reflect.StructField, value Decimal, tree string) error {
+	tag := f.Tag.Get("multiple_of")
+	if tag == "" {
+		return nil
+	}
+	if base, err := ParseDecimal(tag); err == nil && !value.IsMultipleOf(base) {
+		return &Error{
+			ErrType: "NOT_MULTIPLE_ERR",
+			Path:    fieldName(f, tree),
+			Message: fmt.Sprintf("The field <%s> must be a multiple of %s", fieldName(f, tree), base),
+		}
+	}
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
-	"strings"
 )
 
 func (g *Validate) checkDecimalConstraints(f reflect.StructField, v reflect.Value, tree string) error {
@@ -15,22 +14,16 @@ func (g *Validate) checkDecimalConstraints(f reflect.StructField, v reflect.Valu
 		v = v.Elem()
 	}
 
-	if !(v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64) {
+	var str string
+	switch {
+	case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+		str = strconv.FormatFloat(v.Float(), 'f', -1, 64) // string sem notação científica
+	case v.Type() == decimalType:
+		str = v.Interface().(Decimal).String()
+	default:
 		return nil
 	}
-
-	value := v.Float()
-
-	str := strconv.FormatFloat(value, 'f', -1, 64) // string sem notação científica
-	str = strings.TrimRight(str, "0")              // remove zeros à direita
-	str = strings.TrimSuffix(str, ".")             // remove ponto solto
-
-	parts := strings.Split(str, ".")
-	intPart := parts[0]
-	decPart := ""
-	if len(parts) > 1 {
-		decPart = parts[1]
-	}
+	intDigits, decPlaces := countDigits(str)
 
 	// Tags
 	maxDigitsTag := f.Tag.Get("max_digits")
@@ -38,7 +31,7 @@ func (g *Validate) checkDecimalConstraints(f reflect.StructField, v reflect.Valu
 
 	if maxDigitsTag != "" {
 		if maxDigits, err := strconv.Atoi(maxDigitsTag); err == nil {
-			totalDigits := len(strings.TrimLeft(intPart, "-")) + len(decPart)
+			totalDigits := intDigits + decPlaces
 			if totalDigits > maxDigits {
 				return &Error{
 					ErrType: "MAX_DIGITS_ERR",
@@ -50,12 +43,12 @@ func (g *Validate) checkDecimalConstraints(f reflect.StructField, v reflect.Valu
 	}
 
 	if decimalPlacesTag != "" {
-		if decPlaces, err := strconv.Atoi(decimalPlacesTag); err == nil {
-			if len(decPart) > decPlaces {
+		if maxPlaces, err := strconv.Atoi(decimalPlacesTag); err == nil {
+			if decPlaces > maxPlaces {
 				return &Error{
 					ErrType: "DECIMAL_PLACES_ERR",
 					Path:    fieldName(f, tree),
-					Message: fmt.Sprintf("The field <%s> must have at most %d decimal places (got %d)", fieldName(f, tree), decPlaces, len(decPart)),
+					Message: fmt.Sprintf("The field <%s> must have at most %d decimal places (got %d)", fieldName(f, tree), maxPlaces, decPlaces),
 				}
 			}
 		}
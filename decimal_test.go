@@ -0,0 +1,148 @@
+package godantic
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "0"},
+		{"-0.00", "0.00"},
+		{"10.50", "10.50"},
+		{"+7", "7"},
+		{".5", "0.5"},
+		{"007.25", "7.25"},
+		{"1.2e3", "1200"},
+		{"1.25E-3", "0.00125"},
+		{"-12345678901234567890.123456789", "-12345678901234567890.123456789"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := ParseDecimal(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, d.String())
+		})
+	}
+
+	for _, input := range []string{"", ".", "-", "1.2.3", "1e", "1e99999", "abc", "1,5", "0x10", "NaN", "Inf"} {
+		_, err := ParseDecimal(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	sum := MustParseDecimal("0.3")
+	assert.Equal(t, 0, sum.Cmp(MustParseDecimal("0.30")))
+	assert.Equal(t, -1, MustParseDecimal("9223372036854775807").Cmp(MustParseDecimal("9223372036854775808")))
+	assert.True(t, sum.IsMultipleOf(MustParseDecimal("0.1")))
+	assert.False(t, MustParseDecimal("0.35").IsMultipleOf(MustParseDecimal("0.1")))
+	assert.True(t, Decimal{}.IsZero())
+
+	integer, places := MustParseDecimal("-0120.5000").Digits()
+	assert.Equal(t, 3, integer)
+	assert.Equal(t, 1, places)
+}
+
+func TestDecimalJSON(t *testing.T) {
+	type Payment struct {
+		Amount Decimal `json:"amount"`
+	}
+	for _, data := range []string{`{"amount": 12345678901234567.89}`, `{"amount": "12345678901234567.89"}`} {
+		var p Payment
+		assert.NoError(t, json.Unmarshal([]byte(data), &p))
+		assert.Equal(t, "12345678901234567.89", p.Amount.String())
+
+		out, err := json.Marshal(p)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"amount": "12345678901234567.89"}`, string(out))
+	}
+}
+
+func TestDecimalBinding(t *testing.T) {
+	g := &Validate{}
+
+	type Invoice struct {
+		Total    *Decimal `json:"total" binding:"required" gt:"0" le:"999999.99" max_digits:"8" decimal_places:"2"`
+		Discount *Decimal `json:"discount" ge:"0" lt:"1" multiple_of:"0.05"`
+		Fee      *Decimal `json:"fee" min:"0.10" max:"5"`
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		var inv Invoice
+		err := g.BindJSON([]byte(`{"total": 1250.50, "discount": "0.15", "fee": "0.30"}`), &inv)
+		assert.NoError(t, err)
+		assert.Equal(t, "1250.50", inv.Total.String())
+	})
+
+	testCases := []struct {
+		name    string
+		data    string
+		errType string
+		path    string
+	}{
+		{"Missing total", `{"fee": "1"}`, "REQUIRED_FIELD_ERR", "total"},
+		{"Not a number", `{"total": "12,50"}`, "INVALID_DECIMAL_ERR", "total"},
+		{"Boolean", `{"total": true}`, "INVALID_DECIMAL_ERR", "total"},
+		{"Zero total", `{"total": 0}`, "GREATER_THAN_ERR", "total"},
+		{"Total above le", `{"total": "1000000"}`, "LESS_EQUAL_ERR", "total"},
+		{"Too many places", `{"total": "10.001"}`, "DECIMAL_PLACES_ERR", "total"},
+		{"Too many digits", `{"total": "999999.999"}`, "MAX_DIGITS_ERR", "total"},
+		{"Trailing zeros are ignored", `{"total": "10.5000", "discount": "1"}`, "LESS_THAN_ERR", "discount"},
+		{"Not a multiple", `{"total": "10", "discount": "0.12"}`, "NOT_MULTIPLE_ERR", "discount"},
+		{"Negative discount", `{"total": "10", "discount": "-0.05"}`, "GREATER_EQUAL_ERR", "discount"},
+		{"Fee below min", `{"total": "10", "fee": "0.09"}`, "MIN_VALUE_ERR", "fee"},
+		{"Fee above max", `{"total": "10", "fee": "5.01"}`, "MAX_VALUE_ERR", "fee"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var inv Invoice
+			err := g.BindJSON([]byte(tc.data), &inv)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+
+	t.Run("0.3 is a multiple of 0.1", func(t *testing.T) {
+		type Rate struct {
+			Value *Decimal `json:"value" multiple_of:"0.1" max_digits:"1"`
+		}
+		var r Rate
+		assert.NoError(t, g.BindJSON([]byte(`{"value": 0.3}`), &r))
+	})
+
+	t.Run("Malformed bound", func(t *testing.T) {
+		type BadBound struct {
+			Value *Decimal `json:"value" multiple_of:"a tenth"`
+		}
+		err := g.InspectStruct(BadBound{})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+	})
+}
+
+func TestExactIntegerBounds(t *testing.T) {
+	g := &Validate{}
+
+	type Counter struct {
+		Value *int64 `json:"value" lt:"9223372036854775807" multiple_of:"3"`
+	}
+	// Both convert to the same float64, so only an exact comparison sees that
+	// the value isn't below the bound.
+	err := g.InspectStruct(Counter{Value: toPtr(int64(math.MaxInt64))})
+	assert.Error(t, err)
+	assert.Equal(t, "LESS_THAN_ERR", err.(*Error).ErrType)
+
+	assert.NoError(t, g.InspectStruct(Counter{Value: toPtr(int64(math.MaxInt64 - 1))}))
+
+	err = g.InspectStruct(Counter{Value: toPtr(int64(math.MaxInt64 - 2))})
+	assert.Error(t, err)
+	assert.Equal(t, "NOT_MULTIPLE_ERR", err.(*Error).ErrType)
+}
@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 )
 
 func decodeError(err error) error {
 	switch e := err.(type) {
 	case *json.UnmarshalTypeError:
+		if rangeErr := numberRangeError(e); rangeErr != nil {
+			return rangeErr
+		}
 		return &Error{
 			ErrType: "TYPE_MISMATCH_ERR",
 			Path:    e.Field,
@@ -37,6 +41,7 @@ func decodeError(err error) error {
 
 func decodeJSON(jsonData []byte, obj interface{}) error {
 	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
 	err := decoder.Decode(obj)
 	if err != nil {
 		return decodeError(err)
@@ -45,12 +50,23 @@ func decodeJSON(jsonData []byte, obj interface{}) error {
 }
 
 func (g *Validate) BindJSON(jsonData []byte, obj any) error {
-	err := decodeJSON(jsonData, obj)
+	return g.publicError(g.bindJSON(jsonData, obj, false))
+}
+
+func (g *Validate) bindJSON(jsonData []byte, obj any, partial bool) error {
+	if err := g.checkLimits(jsonData); err != nil {
+		return err
+	}
+	jsonData, err := bindTimes(jsonData, obj)
+	if err != nil {
+		return err
+	}
+	err = decodeJSON(jsonData, obj)
 	if err != nil {
 		return err
 	}
 	var reqDataMap map[string]any
-	err = json.Unmarshal(jsonData, &reqDataMap)
+	err = unmarshalJSON(jsonData, &reqDataMap)
 	if err != nil {
 		return &Error{
 			ErrType: "INVALID_JSON_ERR",
@@ -65,15 +81,21 @@ func (g *Validate) BindJSON(jsonData []byte, obj any) error {
 			Message: "The given json data is empty",
 		}
 	}
+	if partial {
+		pg := *g
+		pg.payload = reqDataMap
+		g = &pg
+	}
 	var refDataMap map[string]any
 	refDataBytes, _ := json.Marshal(obj)
-	_ = json.Unmarshal(refDataBytes, &refDataMap)
+	_ = unmarshalJSON(refDataBytes, &refDataMap)
 
 	err = decodeJSON(jsonData, obj)
 	if err != nil {
 		return err
 	}
-	err = g.InspectStruct(obj)
+	g.transform(reflect.ValueOf(obj))
+	err = g.inspectStruct(obj)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,9 @@ package godantic
 import (
 	"encoding/json"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestBindJSON(t *testing.T) {
@@ -68,3 +71,44 @@ type objectPayload struct {
 		State Object `json:"state"`
 	}
 }
+
+func TestBindJSONPointerStruct(t *testing.T) {
+	type address struct {
+		City *string `json:"city"`
+	}
+	type person struct {
+		Home *address   `json:"home"`
+		Seen *time.Time `json:"seen"`
+	}
+	var p person
+	err := (&Validate{}).BindJSON([]byte(`{"home":{"city":"Maputo"},"seen":"2024-01-02T03:04:05Z"}`), &p)
+	assert.NoError(t, err)
+	assert.Equal(t, "Maputo", *p.Home.City)
+}
+
+func TestBindJSONRecursiveStruct(t *testing.T) {
+	type node struct {
+		Name *string `json:"name"`
+		Next *node   `json:"next"`
+	}
+	var n node
+	err := (&Validate{}).BindJSON([]byte(`{"name":"a"}`), &n)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", *n.Name)
+
+	var list node
+	err = (&Validate{}).BindJSON([]byte(`{"name":"a","next":{"name":"b","next":{"name":"c"}}}`), &list)
+	assert.NoError(t, err)
+	assert.Equal(t, "c", *list.Next.Next.Name)
+}
+
+func TestBindJSONRecursiveList(t *testing.T) {
+	type node struct {
+		Name     *string `json:"name"`
+		Children []node  `json:"children"`
+	}
+	var n node
+	err := (&Validate{}).BindJSON([]byte(`{"name":"a","children":[{"name":"b","children":[{"name":"c"}]}]}`), &n)
+	assert.NoError(t, err)
+	assert.Equal(t, "c", *n.Children[0].Children[0].Name)
+}
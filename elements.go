@@ -0,0 +1,240 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The `each`, `keys` and `values` tags apply field rules to the elements of
+// a list or map, e.g. `each:"format=email,max=120"` on a []string. Rules are
+// written name=value and take the same values as the tags of the same name.
+// `each` reaches through nested lists to their elements and, on a map, to
+// its values; `keys` and `values` apply to the keys and values of a map.
+
+// elementRules are the tags that can be used as element rules, besides the
+// tag options of the registered formats.
+var elementRules = map[string]bool{
+	"format": true, "regex": true, "enum": true, "validate": true,
+	"min": true, "max": true, "gt": true, "ge": true, "lt": true, "le": true,
+	"multiple_of": true, "allow_inf_nan": true, "max_digits": true, "decimal_places": true,
+	"currency": true, "min_amount": true, "max_amount": true,
+}
+
+var (
+	elementTags       = []string{"each", "keys", "values"}
+	elementRuleStart  = regexp.MustCompile(`^\s*[a-z_]+\s*=`)
+	parsedElementTags sync.Map
+)
+
+func isElementRule(name string) bool {
+	if elementRules[name] {
+		return true
+	}
+	for _, options := range formatTagOptions {
+		for _, option := range options {
+			if option == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseElementRules turns "format=email,max=120" into the struct tag
+// `format:"email" max:"120"`. A comma only starts a new rule when it is
+// followed by name= outside of parentheses, so `enum=a,b` and
+// `format=phone(MZ,mobile)` keep their commas.
+func parseElementRules(tag string) (reflect.StructTag, error) {
+	if cached, ok := parsedElementTags.Load(tag); ok {
+		return cached.(reflect.StructTag), nil
+	}
+	var names, values []string
+	for _, part := range strings.Split(tag, ",") {
+		if n := len(values); n > 0 && (strings.Count(values[n-1], "(") > strings.Count(values[n-1], ")") || !elementRuleStart.MatchString(part)) {
+			values[n-1] += "," + part
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+		switch {
+		case !ok:
+			return "", fmt.Errorf("'%s' is not a name=value rule", strings.TrimSpace(part))
+		case !isElementRule(name):
+			return "", fmt.Errorf("unknown rule '%s'", name)
+		}
+		for _, seen := range names {
+			if seen == name {
+				return "", fmt.Errorf("rule '%s' is given twice", name)
+			}
+		}
+		names = append(names, name)
+		values = append(values, strings.TrimSpace(value))
+	}
+	rules := make([]string, len(names))
+	for i, name := range names {
+		rules[i] = name + ":" + strconv.Quote(strings.TrimSpace(values[i]))
+	}
+	parsed := reflect.StructTag(strings.Join(rules, " "))
+	parsedElementTags.Store(tag, parsed)
+	return parsed, nil
+}
+
+// elementType returns the type the rules of an element tag apply to, or
+// nil if the tag can't be used on a field of type t.
+func elementType(tag string, t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case tag == "keys":
+		if t.Kind() != reflect.Map {
+			return nil
+		}
+		return t.Key()
+	case tag == "values" && t.Kind() != reflect.Map:
+		return nil
+	case t.Kind() != reflect.Slice && t.Kind() != reflect.Array && t.Kind() != reflect.Map:
+		return nil
+	}
+	for t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map || t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// checkElementConfig reports element tags that can't be parsed or used on
+// the field, and element rules the field checks would reject, such as an
+// unknown format.
+func checkElementConfig(r *Registry, f reflect.StructField) string {
+	for _, tag := range elementTags {
+		value, ok := f.Tag.Lookup(tag)
+		if !ok {
+			continue
+		}
+		t := elementType(tag, f.Type)
+		if t == nil {
+			return fmt.Sprintf("%s: can't be used on a field of type %s", tag, f.Type)
+		}
+		rules, err := parseElementRules(value)
+		if err != nil {
+			return fmt.Sprintf("%s: %v", tag, err)
+		}
+		element := reflect.StructField{Name: f.Name, Type: t, Tag: rules}
+		if message := fieldConfigMessage(r, element); message != "" {
+			return fmt.Sprintf("%s: %s", tag, message)
+		}
+	}
+	return ""
+}
+
+func (g *Validate) checkElements(f reflect.StructField, v reflect.Value, tree string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	path := fieldName(f, tree)
+	for _, tag := range elementTags {
+		value := f.Tag.Get(tag)
+		if value == "" || elementType(tag, f.Type) == nil {
+			continue
+		}
+		rules, err := parseElementRules(value)
+		if err != nil {
+			continue // reported by the type configuration check
+		}
+		if tag == "keys" {
+			for _, key := range sortedMapKeys(v) {
+				if err := g.checkElement(rules, key, fmt.Sprintf("%s[%v]", path, key)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := g.checkEachElement(rules, v, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEachElement applies rules to the elements of v, reaching through
+// nested lists and map values. Paths are indexed, e.g. tags[1][0] or
+// labels[env].
+func (g *Validate) checkEachElement(rules reflect.StructTag, v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := g.checkEachElement(rules, v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(v) {
+			if err := g.checkEachElement(rules, v.MapIndex(key), fmt.Sprintf("%s[%v]", path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return g.checkEachElement(rules, v.Elem(), path)
+	default:
+		return g.checkElement(rules, v, path)
+	}
+	return nil
+}
+
+// checkElement runs the field checks on a single element, as if it were a
+// field tagged with rules.
+func (g *Validate) checkElement(rules reflect.StructTag, v reflect.Value, path string) error {
+	f := reflect.StructField{
+		Name: path,
+		Type: v.Type(),
+		Tag:  reflect.StructTag(`json:` + strconv.Quote(path) + ` ` + string(rules)),
+	}
+	checks := []func(reflect.StructField, reflect.Value, string) error{
+		g.checkMinMax,
+		g.checkNumericConstraints,
+		g.checkDecimalConstraints,
+		g.checkValueBounds,
+		g.checkMoney,
+		g.regexPattern,
+		g.formatValidation,
+	}
+	for _, check := range checks {
+		if err := check(f, v, ""); err != nil {
+			return err
+		}
+	}
+	if err := g.validateWithCustomTag(v.Interface(), f, path); err != nil {
+		return err
+	}
+	if enums := f.Tag.Get("enum"); enums != "" {
+		return g.strEnums(f, v, "", strings.Split(strings.TrimSpace(enums), ","))
+	}
+	return nil
+}
+
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	if v.Kind() != reflect.Map {
+		return nil
+	}
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
+
+func init() {
+	// appended here, checkElementConfig runs the other checks itself
+	registryConfigChecks = append(registryConfigChecks, checkElementConfig)
+}
@@ -0,0 +1,114 @@
+package godantic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElementRules(t *testing.T) {
+	g := &Validate{}
+
+	RegisterCustom[string]("lowercase", func(val string, path string) *Error {
+		if val != strings.ToLower(val) {
+			return &Error{ErrType: "LOWERCASE_ERR", Message: "must be lower case"}
+		}
+		return nil
+	})
+
+	type Profile struct {
+		Emails *[]string          `json:"emails" max:"3" each:"format=email,max=20"`
+		Scores *[]int             `json:"scores" each:"ge=0,le=100"`
+		Roles  *[]string          `json:"roles" each:"enum=admin,editor,viewer"`
+		Grid   *[][]string        `json:"grid" each:"regex=^[a-z]+$"`
+		Labels *map[string]string `json:"labels" keys:"regex=^[a-z_]+$" values:"min=1,max=10,validate=lowercase"`
+		Limits *map[string]int    `json:"limits" each:"multiple_of=5"`
+		Phones *[]string          `json:"phones" each:"format=phone(MZ,mobile)"`
+	}
+
+	valid := Profile{
+		Emails: &[]string{"a@example.com", "b@example.com"},
+		Scores: &[]int{0, 50, 100},
+		Roles:  &[]string{"admin", "viewer"},
+		Grid:   &[][]string{{"a", "b"}, {"c"}},
+		Labels: &map[string]string{"env": "prod", "team_name": "core"},
+		Limits: &map[string]int{"cpu": 10, "memory": 5},
+		Phones: &[]string{"+258841234567"},
+	}
+	assert.NoError(t, g.InspectStruct(valid))
+
+	testCases := []struct {
+		name    string
+		profile Profile
+		errType string
+		path    string
+	}{
+		{"Too many emails", Profile{Emails: &[]string{"a@x.co", "b@x.co", "c@x.co", "d@x.co"}}, "MAX_LENGTH_ERR", "emails"},
+		{"Invalid email", Profile{Emails: &[]string{"a@example.com", "nope"}}, "INVALID_EMAIL_ERR", "emails[1]"},
+		{"Email too long", Profile{Emails: &[]string{"someone@example-company.com"}}, "MAX_LENGTH_ERR", "emails[0]"},
+		{"Score out of range", Profile{Scores: &[]int{10, 101}}, "LESS_EQUAL_ERR", "scores[1]"},
+		{"Unknown role", Profile{Roles: &[]string{"admin", "root"}}, "INVALID_ENUM_ERR", "roles[1]"},
+		{"Nested list element", Profile{Grid: &[][]string{{"a"}, {"b", "C"}}}, "INVALID_PATTERN_ERR", "grid[1][1]"},
+		{"Invalid map key", Profile{Labels: &map[string]string{"Env": "prod"}}, "INVALID_PATTERN_ERR", "labels[Env]"},
+		{"Map value too long", Profile{Labels: &map[string]string{"env": "production-eu"}}, "MAX_LENGTH_ERR", "labels[env]"},
+		{"Custom rule on a map value", Profile{Labels: &map[string]string{"env": "Prod"}}, "LOWERCASE_ERR", "labels[env]"},
+		{"Map value not a multiple", Profile{Limits: &map[string]int{"cpu": 10, "memory": 7}}, "NOT_MULTIPLE_ERR", "limits[memory]"},
+		{"Phone outside the region", Profile{Phones: &[]string{"+27821234567"}}, "INVALID_PHONE_ERR", "phones[0]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.profile)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+
+	t.Run("Binding", func(t *testing.T) {
+		var p Profile
+		err := g.BindJSON([]byte(`{"emails": ["a@example.com", "b@"]}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_EMAIL_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "emails[1]", err.(*Error).Path)
+	})
+}
+
+func TestElementRulesConfig(t *testing.T) {
+	g := &Validate{}
+
+	testCases := []struct {
+		name  string
+		value any
+	}{
+		{"Unknown rule", struct {
+			Tags *[]string `json:"tags" each:"colour=red"`
+		}{}},
+		{"Rule without a value", struct {
+			Tags *[]string `json:"tags" each:"email"`
+		}{}},
+		{"Rule given twice", struct {
+			Tags *[]string `json:"tags" each:"max=3,max=4"`
+		}{}},
+		{"Unknown format", struct {
+			Tags *[]string `json:"tags" each:"format=colour"`
+		}{}},
+		{"Negative bound on unsigned elements", struct {
+			Tags *[]uint `json:"tags" each:"ge=-1"`
+		}{}},
+		{"each on a string", struct {
+			Tags *string `json:"tags" each:"max=3"`
+		}{}},
+		{"keys on a slice", struct {
+			Tags *[]string `json:"tags" keys:"max=3"`
+		}{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.value)
+			assert.Error(t, err)
+			assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+			assert.Equal(t, "tags", err.(*Error).Path)
+		})
+	}
+}
@@ -3,12 +3,33 @@ package godantic
 
 import (
 	"fmt"
+	"reflect"
 )
 
 type Validate struct {
 	IgnoreRequired     bool
 	IgnoreMinLen       bool
 	AllowUnknownFields bool
+	// Registry holds the formats and validators used, DefaultRegistry()
+	// when nil.
+	Registry *Registry
+
+	// payload holds the raw decoded request during a partial bind.
+	payload map[string]any
+	// root and parents give struct validators the value being inspected
+	// and the structs enclosing the current one.
+	root    any
+	parents []reflect.Value
+	// indices maps the paths of the list items being checked to their
+	// indexed form, for ValidationPluginV2.
+	indices []pathIndex
+
+	// set by the options of New and NewValidator
+	locale    string
+	maxBytes  int
+	maxDepth  int
+	errorMode ErrorMode
+	configs   *typeCache
 }
 
 func (g *Validate) CheckTypeCompatibility(reqData, refData map[string]any) error {
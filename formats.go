@@ -0,0 +1,162 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// FormatFunc reports whether value satisfies a format. params holds the
+// arguments given in the tag, e.g. `format:"name(a,b)"` yields ["a", "b"].
+type FormatFunc func(value string, params []string) bool
+
+var (
+	compiledRegexes sync.Map
+
+	// formatTagOptions lists, per format, the struct tags whose values are
+	// passed to the format as extra "tag=value" parameters.
+	formatTagOptions = make(map[string][]string)
+)
+
+// RegisterFormat makes fn available to the `format` tag under name,
+// replacing any format already registered with that name.
+func RegisterFormat(name string, fn func(string) bool) {
+	defaultRegistry.RegisterFormat(name, fn)
+}
+
+// RegisterFormatWithParams registers a format that receives the arguments
+// given in the tag, e.g. `format:"name(a,b)"`.
+func RegisterFormatWithParams(name string, fn FormatFunc) {
+	defaultRegistry.RegisterFormatWithParams(name, fn)
+}
+
+// RegisterFormatRegex registers a format backed by a regular expression.
+// The pattern is compiled once and panics if it is invalid.
+func RegisterFormatRegex(name, pattern string) {
+	defaultRegistry.RegisterFormatRegex(name, pattern)
+}
+
+func lookupFormat(name string) (FormatFunc, bool) {
+	return defaultRegistry.lookupFormat(name)
+}
+
+// RegisteredFormats returns the names usable in the `format` tag, sorted.
+func RegisteredFormats() []string {
+	return defaultRegistry.Formats()
+}
+
+// parseFormatTag splits a `format` tag into its name and parameters.
+func parseFormatTag(tag string) (string, []string, error) {
+	tag = strings.TrimSpace(tag)
+	open := strings.Index(tag, "(")
+	if open == -1 {
+		return tag, nil, nil
+	}
+	if !strings.HasSuffix(tag, ")") {
+		return "", nil, fmt.Errorf("format '%s' is missing a closing parenthesis", tag)
+	}
+	name := strings.TrimSpace(tag[:open])
+	var params []string
+	for _, param := range strings.Split(tag[open+1:len(tag)-1], ",") {
+		if param = strings.TrimSpace(param); param != "" {
+			params = append(params, param)
+		}
+	}
+	return name, params, nil
+}
+
+func checkFormatConfig(r *Registry, f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("format")
+	if !ok {
+		return ""
+	}
+	name, _, err := parseFormatTag(tag)
+	if err != nil {
+		return err.Error()
+	}
+	if _, ok := r.lookupFormat(name); !ok {
+		return fmt.Sprintf("unknown format '%s'", name)
+	}
+	return ""
+}
+
+func (g *Validate) formatValidation(f reflect.StructField, v reflect.Value, tree string) error {
+	tag := f.Tag.Get("format")
+	if tag == "" {
+		return nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	name, params, err := parseFormatTag(tag)
+	if err != nil {
+		return nil // reported by the type configuration check
+	}
+	fn, ok := g.registry().lookupFormat(name)
+	if !ok {
+		return nil
+	}
+	for _, option := range formatTagOptions[name] {
+		if value, ok := f.Tag.Lookup(option); ok {
+			params = append(params, option+"="+value)
+		}
+	}
+	fieldValue := v.String()
+	if !fn(fieldValue, params) {
+		return &Error{
+			ErrType: fmt.Sprintf("INVALID_%s_ERR", strings.ToUpper(name)),
+			Path:    fieldName(f, tree),
+			Message: fmt.Sprintf("error on field <%s>. the given value '%s' is not a valid %s", fieldName(f, tree), fieldValue, name),
+		}
+	}
+	return nil
+}
+
+// compileRegex compiles pattern once and serves later calls from a cache.
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := compiledRegexes.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	compiledRegexes.Store(pattern, re)
+	return re, nil
+}
+
+func matchRegexPattern(regexpPattern, fieldValue string, f reflect.StructField, tree string) error {
+	regexpPatternCompiled, err := compileRegex(regexpPattern)
+	if err != nil {
+		return err // Handle error
+	}
+	// Check if the field's value matches the regular expression pattern
+	if !regexpPatternCompiled.MatchString(fieldValue) {
+		return &Error{
+			ErrType: "INVALID_PATTERN_ERR",
+			Path:    fieldName(f, tree),
+			Message: fmt.Sprintf("The field <%s> value '%s' does not match the required pattern: %s", fieldName(f, tree), fieldValue, regexpPattern),
+		}
+	}
+	return nil
+}
+
+func init() {
+	for name, pattern := range map[string]string{
+		"time":               `^([01]\d|2[0-3]):([0-5]\d):([0-5]\d)$`,
+		"uuid":               `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+		"postal_code":        `^[a-zA-Z0-9]+$`,
+		"ssn":                `^\d{3}-\d{2}-\d{4}$`,
+		"credit_card_expiry": `^(0[1-9]|1[0-2])\/(20\d{2}|2[1-9]\d{1})$`,
+		"latitude":           `^(-?([0-8]?[0-9]\.\d+|90(\.0+)?))$`,
+		"longitude":          `^(-?((1?[0-7]?|[0-9]?)[0-9]\.\d+|180(\.0+)?))$`,
+		"hex_color":          `^#?([a-fA-F0-9]{6}|[a-fA-F0-9]{3})$`,
+	} {
+		RegisterFormatRegex(name, pattern)
+	}
+}
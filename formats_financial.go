@@ -0,0 +1,158 @@
+package godantic
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Card brands reported by CardBrand.
+const (
+	CardBrandVisa       = "visa"
+	CardBrandMastercard = "mastercard"
+	CardBrandAmex       = "amex"
+)
+
+var bicRegex = regexp.MustCompile(`^[A-Z]{4}([A-Z]{2})[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// countryAlpha2 is the reverse of countryAlpha3.
+var countryAlpha2 = func() map[string]string {
+	m := make(map[string]string, len(countryAlpha3))
+	for alpha2, alpha3 := range countryAlpha3 {
+		m[alpha3] = alpha2
+	}
+	return m
+}()
+
+// cardDigits strips the space or hyphen separators of a card number and
+// returns "" when anything other than digits is left.
+func cardDigits(number string) string {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(number)
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return ""
+		}
+	}
+	return digits
+}
+
+// ValidLuhn reports whether digits, a string of decimal digits, ends in a
+// valid Luhn check digit, as card and many identity numbers do. It returns
+// false for an empty string or one holding anything but digits.
+func ValidLuhn(digits string) bool {
+	if !isDigits(digits) {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// CardBrand returns the brand of a card number (CardBrandVisa,
+// CardBrandMastercard or CardBrandAmex) from its prefix and length, or ""
+// when the brand isn't recognised. Separators are ignored and the check
+// digit is not verified.
+func CardBrand(number string) string {
+	digits := cardDigits(number)
+	n := len(digits)
+	switch {
+	case n == 0:
+		return ""
+	case digits[0] == '4' && (n == 13 || n == 16 || n == 19):
+		return CardBrandVisa
+	case n == 15 && (strings.HasPrefix(digits, "34") || strings.HasPrefix(digits, "37")):
+		return CardBrandAmex
+	case n == 16:
+		prefix2 := digits[:2]
+		prefix4 := digits[:4]
+		if (prefix2 >= "51" && prefix2 <= "55") || (prefix4 >= "2221" && prefix4 <= "2720") {
+			return CardBrandMastercard
+		}
+	}
+	return ""
+}
+
+// isCreditCard validates a Luhn-checked card number of 12 to 19 digits.
+// When brands are given as parameters, the number must belong to one of them.
+func isCreditCard(s string, brands []string) bool {
+	digits := cardDigits(s)
+	if len(digits) < 12 || len(digits) > 19 || !ValidLuhn(digits) {
+		return false
+	}
+	if len(brands) == 0 {
+		return true
+	}
+	brand := CardBrand(digits)
+	return brand != "" && hasParam(brands, brand)
+}
+
+// isIBAN validates an IBAN with its country length and mod-97 check digits.
+// Spaces between the groups are accepted.
+func isIBAN(s string) bool {
+	iban := strings.ReplaceAll(s, " ", "")
+	if len(iban) < 5 {
+		return false
+	}
+	length, ok := ibanLengths[iban[:2]]
+	if !ok || len(iban) != length {
+		return false
+	}
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// isBIC validates a BIC (SWIFT code) with 8 or 11 characters and a known
+// country. XK is accepted for Kosovo, which uses a user-assigned code.
+func isBIC(s string) bool {
+	m := bicRegex.FindStringSubmatch(s)
+	if m == nil {
+		return false
+	}
+	_, ok := countryAlpha3[m[1]]
+	return ok || m[1] == "XK"
+}
+
+func isCurrencyCode(s string) bool {
+	_, ok := currencyMinorUnits[s]
+	return ok
+}
+
+func isCountryAlpha2(s string) bool {
+	_, ok := countryAlpha3[s]
+	return ok
+}
+
+func isCountryAlpha3(s string) bool {
+	_, ok := countryAlpha2[s]
+	return ok
+}
+
+func init() {
+	RegisterFormatWithParams("credit_card", isCreditCard)
+	RegisterFormat("iban", isIBAN)
+	RegisterFormat("bic", isBIC)
+	RegisterFormat("swift", isBIC)
+	RegisterFormat("iso4217", isCurrencyCode)
+	RegisterFormat("iso3166_alpha2", isCountryAlpha2)
+	RegisterFormat("iso3166_alpha3", isCountryAlpha3)
+}
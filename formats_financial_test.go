@@ -0,0 +1,99 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinancialFormats(t *testing.T) {
+	testCases := []struct {
+		format   string
+		input    string
+		expected bool
+	}{
+		{"credit_card", "4111111111111111", true},
+		{"credit_card", "4111 1111 1111 1111", true},
+		{"credit_card", "5555-5555-5555-4444", true},
+		{"credit_card", "378282246310005", true},
+		{"credit_card", "4111111111111112", false},
+		{"credit_card", "4111-1111-1111-111a", false},
+		{"credit_card", "41111", false},
+		{"credit_card(visa)", "4111111111111111", true},
+		{"credit_card(visa)", "5555555555554444", false},
+		{"credit_card(mastercard,amex)", "2223003122003222", true},
+		{"credit_card(mastercard,amex)", "378282246310005", true},
+		{"iban", "GB82WEST12345698765432", true},
+		{"iban", "GB82 WEST 1234 5698 7654 32", true},
+		{"iban", "DE89370400440532013000", true},
+		{"iban", "PT50000201231234567890154", true},
+		{"iban", "GB82WEST12345698765431", false},
+		{"iban", "GB82WEST1234569876543", false},
+		{"iban", "XX82WEST12345698765432", false},
+		{"bic", "DEUTDEFF", true},
+		{"bic", "DEUTDEFF500", true},
+		{"swift", "BIMOMZMX", true},
+		{"bic", "DEUTQQFF", false},
+		{"bic", "DEUT", false},
+		{"iso4217", "MZN", true},
+		{"iso4217", "JPY", true},
+		{"iso4217", "XYZ", false},
+		{"iso4217", "usd", false},
+		{"iso3166_alpha2", "MZ", true},
+		{"iso3166_alpha2", "ZZ", false},
+		{"iso3166_alpha3", "MOZ", true},
+		{"iso3166_alpha3", "MZ", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format+"/"+tc.input, func(t *testing.T) {
+			name, params, err := parseFormatTag(tc.format)
+			assert.NoError(t, err)
+			fn, ok := lookupFormat(name)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, fn(tc.input, params))
+		})
+	}
+}
+
+func TestCardBrand(t *testing.T) {
+	assert.Equal(t, CardBrandVisa, CardBrand("4111 1111 1111 1111"))
+	assert.Equal(t, CardBrandMastercard, CardBrand("5105-1051-0510-5100"))
+	assert.Equal(t, CardBrandMastercard, CardBrand("2221000000000009"))
+	assert.Equal(t, CardBrandAmex, CardBrand("371449635398431"))
+	assert.Equal(t, "", CardBrand("6011111111111117"))
+	assert.Equal(t, "", CardBrand("not a card"))
+}
+
+func TestValidLuhn(t *testing.T) {
+	assert.True(t, ValidLuhn("79927398713"))
+	assert.True(t, ValidLuhn("4111111111111111"))
+	assert.False(t, ValidLuhn("79927398710"))
+	assert.False(t, ValidLuhn(""))
+	assert.False(t, ValidLuhn("7992 7398 713"))
+	assert.False(t, ValidLuhn("0000a"))
+}
+
+func TestFinancialFormatTags(t *testing.T) {
+	g := &Validate{}
+
+	type Payment struct {
+		Card     *string `json:"card" format:"credit_card(visa,mastercard)"`
+		IBAN     *string `json:"iban" format:"iban"`
+		Currency *string `json:"currency" format:"iso4217"`
+	}
+
+	assert.NoError(t, g.InspectStruct(Payment{
+		Card:     toPtr("4111-1111-1111-1111"),
+		IBAN:     toPtr("PT50000201231234567890154"),
+		Currency: toPtr("MZN"),
+	}))
+
+	err := g.InspectStruct(Payment{Card: toPtr("378282246310005")})
+	assert.Error(t, err)
+	assert.Equal(t, "INVALID_CREDIT_CARD_ERR", err.(*Error).ErrType)
+
+	err = g.InspectStruct(Payment{IBAN: toPtr("PT50000201231234567890155")})
+	assert.Error(t, err)
+	assert.Equal(t, "INVALID_IBAN_ERR", err.(*Error).ErrType)
+}
@@ -0,0 +1,147 @@
+package godantic
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Mozambican mobile operators reported by ParseMZMSISDN.
+const (
+	MZOperatorTmcel   = "tmcel"
+	MZOperatorVodacom = "vodacom"
+	MZOperatorMovitel = "movitel"
+)
+
+// mzOperatorPrefixes maps the two-digit mobile prefixes to their operator.
+var mzOperatorPrefixes = map[string]string{
+	"82": MZOperatorTmcel,
+	"83": MZOperatorTmcel,
+	"84": MZOperatorVodacom,
+	"85": MZOperatorVodacom,
+	"86": MZOperatorMovitel,
+	"87": MZOperatorMovitel,
+}
+
+// MZMSISDN is a Mozambican mobile number split into its parts.
+type MZMSISDN struct {
+	// National is the 9-digit national number, e.g. "841234567".
+	National string
+	// Operator is one of the MZOperator constants.
+	Operator string
+}
+
+// E164 returns the number in E.164 form, e.g. "+258841234567".
+func (m MZMSISDN) E164() string {
+	return "+258" + m.National
+}
+
+// ParseMZMSISDN parses a Mozambican mobile number given in national
+// ("841234567"), international ("258841234567", "00258841234567") or E.164
+// ("+258841234567") form. Spaces and hyphens are ignored. The number must
+// belong to one of the operator ranges 82 to 87.
+func ParseMZMSISDN(s string) (MZMSISDN, error) {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+	switch {
+	case strings.HasPrefix(digits, "+258"):
+		digits = digits[4:]
+	case strings.HasPrefix(digits, "00258"):
+		digits = digits[5:]
+	case strings.HasPrefix(digits, "258") && len(digits) == 12:
+		digits = digits[3:]
+	}
+	if len(digits) != 9 || !isDigits(digits) {
+		return MZMSISDN{}, fmt.Errorf("'%s' is not a Mozambican mobile number", s)
+	}
+	operator, ok := mzOperatorPrefixes[digits[:2]]
+	if !ok {
+		return MZMSISDN{}, fmt.Errorf("'%s' does not belong to a Mozambican operator range", s)
+	}
+	return MZMSISDN{National: digits, Operator: operator}, nil
+}
+
+// NormalizeMZMSISDN returns a Mozambican mobile number in E.164 form.
+func NormalizeMZMSISDN(s string) (string, error) {
+	m, err := ParseMZMSISDN(s)
+	if err != nil {
+		return "", err
+	}
+	return m.E164(), nil
+}
+
+// isMZMSISDN validates a Mozambican mobile number. Operators given as
+// parameters restrict the accepted ranges, e.g. `format:"mz-msisdn(vodacom)"`.
+func isMZMSISDN(s string, operators []string) bool {
+	m, err := ParseMZMSISDN(s)
+	if err != nil {
+		return false
+	}
+	return len(operators) == 0 || hasParam(operators, m.Operator)
+}
+
+// ValidMZNUIT reports whether s has the structure of a NUIT (Número Único
+// de Identificação Tributária): 9 digits. The Autoridade Tributária doesn't
+// publish how the last digit is computed, so it isn't checked.
+func ValidMZNUIT(s string) bool {
+	return len(s) == 9 && isDigits(s)
+}
+
+var (
+	mzBIRegex   = regexp.MustCompile(`^(\d{2})\d{10}[A-Z]$`)
+	mzDIRERegex = regexp.MustCompile(`^(\d{2})([A-Z]{2})\d{8}[A-Z]$`)
+)
+
+// isMZProvinceCode checks the province code that prefixes BI and DIRE
+// numbers, from 01 (Niassa) to 11 (Maputo Cidade).
+func isMZProvinceCode(code string) bool {
+	return code >= "01" && code <= "11"
+}
+
+// ValidMZBI reports whether s has the structure of a Bilhete de Identidade
+// number: a province code, 10 digits and an uppercase letter.
+func ValidMZBI(s string) bool {
+	m := mzBIRegex.FindStringSubmatch(s)
+	return m != nil && isMZProvinceCode(m[1])
+}
+
+// ValidMZDIRE reports whether s has the structure of a DIRE (residence
+// document for foreigners) number: a province code, the holder's ISO 3166-1
+// alpha-2 nationality, 8 digits and an uppercase letter.
+func ValidMZDIRE(s string) bool {
+	m := mzDIRERegex.FindStringSubmatch(s)
+	if m == nil || !isMZProvinceCode(m[1]) || m[2] == "MZ" {
+		return false
+	}
+	return isCountryAlpha2(m[2])
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// The Mozambican pack ships with the core package, unlike the packs/
+// subpackages, so the mz- formats keep working without an extra import.
+func init() {
+	RegisterPack(FormatPack{
+		Country: "mz",
+		Formats: map[string]FormatFunc{
+			"msisdn": isMZMSISDN,
+			"nuit":   func(s string, _ []string) bool { return ValidMZNUIT(s) },
+			"bi":     func(s string, _ []string) bool { return ValidMZBI(s) },
+			"dire":   func(s string, _ []string) bool { return ValidMZDIRE(s) },
+		},
+	})
+	// `transform:"mz-msisdn"` stores numbers in E.164 form when binding.
+	// Invalid numbers are left as they are, for the format to report.
+	RegisterTransformer("mz-msisdn", func(s string) string {
+		if normalized, err := NormalizeMZMSISDN(s); err == nil {
+			return normalized
+		}
+		return s
+	})
+}
@@ -0,0 +1,87 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMZMSISDN(t *testing.T) {
+	testCases := []struct {
+		input    string
+		e164     string
+		operator string
+	}{
+		{"841234567", "+258841234567", MZOperatorVodacom},
+		{"258851234567", "+258851234567", MZOperatorVodacom},
+		{"+258 82 123 4567", "+258821234567", MZOperatorTmcel},
+		{"00258831234567", "+258831234567", MZOperatorTmcel},
+		{"86-123-4567", "+258861234567", MZOperatorMovitel},
+		{"+258871234567", "+258871234567", MZOperatorMovitel},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			m, err := ParseMZMSISDN(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.e164, m.E164())
+			assert.Equal(t, tc.operator, m.Operator)
+
+			normalized, err := NormalizeMZMSISDN(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.e164, normalized)
+		})
+	}
+
+	for _, input := range []string{"258811234567", "+258211234567", "84123456", "2588412345678", "84abc4567", ""} {
+		_, err := ParseMZMSISDN(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestMZFormats(t *testing.T) {
+	testCases := []struct {
+		format   string
+		input    string
+		expected bool
+	}{
+		{"mz-msisdn", "+258841234567", true},
+		{"mz-msisdn", "258801234567", false},
+		{"mz-msisdn(vodacom)", "851234567", true},
+		{"mz-msisdn(vodacom)", "861234567", false},
+		{"mz-msisdn(tmcel,movitel)", "871234567", true},
+		{"mz-nuit", "400123452", true},
+		{"mz-nuit", "40012345", false},
+		{"mz-nuit", "40012345A", false},
+		{"mz-bi", "110100123456B", true},
+		{"mz-bi", "120100123456B", false},
+		{"mz-bi", "110100123456b", false},
+		{"mz-dire", "11PT00012345A", true},
+		{"mz-dire", "11MZ00012345A", false},
+		{"mz-dire", "11QQ00012345A", false},
+		{"mz-dire", "13PT00012345A", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.format+"/"+tc.input, func(t *testing.T) {
+			name, params, err := parseFormatTag(tc.format)
+			assert.NoError(t, err)
+			fn, ok := lookupFormat(name)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, fn(tc.input, params))
+		})
+	}
+}
+
+func TestMZMSISDNTransformer(t *testing.T) {
+	type contact struct {
+		Phone *string `json:"phone" transform:"mz-msisdn" format:"mz-msisdn"`
+	}
+	var c contact
+	err := (&Validate{}).BindJSON([]byte(`{"phone":"84 123 4567"}`), &c)
+	assert.NoError(t, err)
+	assert.Equal(t, "+258841234567", *c.Phone)
+
+	err = (&Validate{}).BindJSON([]byte(`{"phone":"811234567"}`), &contact{})
+	if assert.Error(t, err) {
+		assert.Equal(t, "phone", err.(*Error).Path)
+	}
+}
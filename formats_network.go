@@ -0,0 +1,186 @@
+package godantic
+
+import (
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func isIPv4(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is4()
+}
+
+func isIPv6(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is6()
+}
+
+func isIP(s string) bool {
+	_, err := netip.ParseAddr(s)
+	return err == nil
+}
+
+func isCIDR(s string) bool {
+	_, err := netip.ParsePrefix(s)
+	return err == nil
+}
+
+// isHostname validates an RFC 1123 host name. With unicode set, labels may
+// also hold non-ASCII letters and digits (internationalized domain names).
+func isHostname(s string, unicodeLabels bool) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if !isHostnameLabel(label, unicodeLabels) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHostnameLabel(label string, unicodeLabels bool) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, r := range label {
+		switch {
+		case r == '-', r < utf8.RuneSelf && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'):
+		case unicodeLabels && r >= utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isFQDN validates a fully qualified domain name: a host name with at least
+// two labels whose top-level label isn't numeric.
+func isFQDN(s string, unicodeLabels bool) bool {
+	if !isHostname(s, unicodeLabels) {
+		return false
+	}
+	labels := strings.Split(strings.TrimSuffix(s, "."), ".")
+	if len(labels) < 2 {
+		return false
+	}
+	_, err := strconv.Atoi(labels[len(labels)-1])
+	return err != nil
+}
+
+// isURIHost validates the host of a parsed URL, as returned by Hostname.
+func isURIHost(host string) bool {
+	return isIP(host) || isHostname(host, true)
+}
+
+func hasNoSpace(s string) bool {
+	return strings.IndexFunc(s, unicode.IsSpace) == -1
+}
+
+// isURI validates an absolute URI (RFC 3986), whatever its scheme.
+func isURI(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || !hasNoSpace(s) {
+		return false
+	}
+	return u.Host == "" || isURIHost(u.Hostname())
+}
+
+// isURIReference validates an absolute or relative URI reference.
+func isURIReference(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || !hasNoSpace(s) {
+		return false
+	}
+	return u.Host == "" || isURIHost(u.Hostname())
+}
+
+// isURL validates an http or https URL with a host. Ports, fragments and
+// internationalized host names are accepted.
+func isURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || !hasNoSpace(s) || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return false
+	}
+	return isURIHost(u.Hostname())
+}
+
+// isEmail validates an RFC 5322 addr-spec without a display name. Non-ASCII
+// characters are only accepted with the "idn" parameter.
+func isEmail(s string, params []string) bool {
+	idn := hasParam(params, "idn")
+	if !idn && !isASCII(s) {
+		return false
+	}
+	if strings.ContainsAny(s, "<>") || strings.TrimSpace(s) != s {
+		return false
+	}
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Name != "" {
+		return false
+	}
+	at := strings.LastIndex(s, "@")
+	local, domain := s[:at], s[at+1:]
+	if len(local) > 64 {
+		return false
+	}
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		literal := strings.TrimPrefix(domain[1:len(domain)-1], "IPv6:")
+		return isIP(literal)
+	}
+	return isFQDN(domain, idn)
+}
+
+func isPort(s string) bool {
+	port, err := strconv.ParseUint(s, 10, 16)
+	return err == nil && port > 0
+}
+
+func isMACAddress(s string) bool {
+	_, err := net.ParseMAC(s)
+	return err == nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func hasParam(params []string, name string) bool {
+	for _, p := range params {
+		if strings.EqualFold(p, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	RegisterFormat("ipv4", isIPv4)
+	RegisterFormat("ipv6", isIPv6)
+	RegisterFormat("ip", isIP)
+	RegisterFormat("cidr", isCIDR)
+	RegisterFormatWithParams("hostname", func(s string, params []string) bool {
+		return isHostname(s, hasParam(params, "idn"))
+	})
+	RegisterFormatWithParams("fqdn", func(s string, params []string) bool {
+		return isFQDN(s, hasParam(params, "idn"))
+	})
+	RegisterFormat("uri", isURI)
+	RegisterFormat("uri_reference", isURIReference)
+	RegisterFormat("url", isURL)
+	RegisterFormatWithParams("email", isEmail)
+	RegisterFormat("port", isPort)
+	RegisterFormat("mac_address", isMACAddress)
+}
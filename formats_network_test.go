@@ -0,0 +1,105 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkFormats(t *testing.T) {
+	testCases := []struct {
+		format   string
+		input    string
+		expected bool
+	}{
+		{"ipv4", "192.168.1.1", true},
+		{"ipv4", "999.999.999.999", false},
+		{"ipv4", "2001:db8::1", false},
+		{"ipv6", "2001:db8::1", true},
+		{"ipv6", "::1", true},
+		{"ipv6", "192.168.1.1", false},
+		{"ip", "10.0.0.1", true},
+		{"ip", "fe80::1", true},
+		{"ip", "999.999.999.999", false},
+		{"cidr", "10.0.0.0/8", true},
+		{"cidr", "2001:db8::/32", true},
+		{"cidr", "10.0.0.0/33", false},
+		{"cidr", "10.0.0.0", false},
+		{"hostname", "localhost", true},
+		{"hostname", "api-1.example.co.mz", true},
+		{"hostname", "1password.com", true},
+		{"hostname", "-bad.example.com", false},
+		{"hostname", "under_score.example.com", false},
+		{"hostname", "münchen.de", false},
+		{"hostname(idn)", "münchen.de", true},
+		{"fqdn", "example.com", true},
+		{"fqdn", "example.com.", true},
+		{"fqdn", "localhost", false},
+		{"fqdn", "10.0.0.1", false},
+		{"uri", "https://example.com:8443/path?q=1#frag", true},
+		{"uri", "mailto:someone@example.com", true},
+		{"uri", "urn:isbn:0451450523", true},
+		{"uri", "/relative/path", false},
+		{"uri", "http://exa mple.com", false},
+		{"uri_reference", "/relative/path?x=1", true},
+		{"uri_reference", "../up#top", true},
+		{"uri_reference", "https://example.com", true},
+		{"uri_reference", "has space", false},
+		{"url", "http://example.com", true},
+		{"url", "https://example.com:8080/a/b?c=d#e", true},
+		{"url", "https://münchen.de/straße", true},
+		{"url", "http://[::1]:8080/", true},
+		{"url", "ftp://example.com", false},
+		{"url", "invalid-url", false},
+		{"email", "test@example.com", true},
+		{"email", "first.last+tag@sub.example.co.mz", true},
+		{"email", `"john doe"@example.com`, true},
+		{"email", "user@[192.168.0.1]", true},
+		{"email", "invalid-email", false},
+		{"email", "John <john@example.com>", false},
+		{"email", "john@localhost", false},
+		{"email", "josé@example.com", false},
+		{"email(idn)", "josé@münchen.de", true},
+		{"email(idn)", "josé@-bad.de", false},
+		{"port", "8080", true},
+		{"port", "65535", true},
+		{"port", "0", false},
+		{"port", "65536", false},
+		{"port", "http", false},
+		{"mac_address", "00:0a:95:9d:68:16", true},
+		{"mac_address", "00-0A-95-9D-68-16", true},
+		{"mac_address", "0000.5e00.5301", true},
+		{"mac_address", "00:0a:95:9d:68", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format+"/"+tc.input, func(t *testing.T) {
+			name, params, err := parseFormatTag(tc.format)
+			assert.NoError(t, err)
+			fn, ok := lookupFormat(name)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, fn(tc.input, params))
+		})
+	}
+}
+
+func TestNetworkFormatTags(t *testing.T) {
+	g := &Validate{}
+
+	type Server struct {
+		Host  *string `json:"host" format:"hostname"`
+		Addr  *string `json:"addr" format:"ip"`
+		Owner *string `json:"owner" format:"email(idn)"`
+	}
+
+	assert.NoError(t, g.InspectStruct(Server{
+		Host:  toPtr("db.internal"),
+		Addr:  toPtr("2001:db8::10"),
+		Owner: toPtr("joão@exemplo.pt"),
+	}))
+
+	err := g.InspectStruct(Server{Addr: toPtr("999.999.999.999")})
+	assert.Error(t, err)
+	assert.Equal(t, "INVALID_IP_ERR", err.(*Error).ErrType)
+	assert.Equal(t, "addr", err.(*Error).Path)
+}
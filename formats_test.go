@@ -0,0 +1,97 @@
+package godantic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRegistry(t *testing.T) {
+	g := &Validate{}
+
+	t.Run("should use a registered format function", func(t *testing.T) {
+		RegisterFormat("upper", func(s string) bool {
+			return s == strings.ToUpper(s)
+		})
+		type S struct {
+			Code *string `json:"code" format:"upper"`
+		}
+		assert.NoError(t, g.InspectStruct(S{Code: toPtr("ABC")}))
+
+		err := g.InspectStruct(S{Code: toPtr("abc")})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_UPPER_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "code", err.(*Error).Path)
+	})
+
+	t.Run("should use a registered format regex", func(t *testing.T) {
+		RegisterFormatRegex("sku", `^[A-Z]{3}-\d{4}$`)
+		type S struct {
+			SKU *string `json:"sku" format:"sku"`
+		}
+		assert.NoError(t, g.InspectStruct(S{SKU: toPtr("ABC-1234")}))
+		assert.Error(t, g.InspectStruct(S{SKU: toPtr("abc-12")}))
+	})
+
+	t.Run("should pass tag parameters to the format", func(t *testing.T) {
+		RegisterFormatWithParams("prefixed", func(s string, params []string) bool {
+			for _, p := range params {
+				if strings.HasPrefix(s, p) {
+					return true
+				}
+			}
+			return false
+		})
+		type S struct {
+			Ref *string `json:"ref" format:"prefixed(INV-, ORD-)"`
+		}
+		assert.NoError(t, g.InspectStruct(S{Ref: toPtr("ORD-1")}))
+		assert.Error(t, g.InspectStruct(S{Ref: toPtr("REF-1")}))
+	})
+
+	t.Run("should report unknown formats as a configuration error", func(t *testing.T) {
+		type S struct {
+			Value *string `json:"value" format:"does_not_exist"`
+		}
+		err := g.InspectStruct(S{})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "value", err.(*Error).Path)
+		assert.Contains(t, err.Error(), "unknown format 'does_not_exist'")
+	})
+
+	t.Run("should report malformed parameters as a configuration error", func(t *testing.T) {
+		type S struct {
+			Value *string `json:"value" format:"prefixed(a"`
+		}
+		err := g.InspectStruct(S{})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+	})
+
+	t.Run("should accept a format registered after the first inspection", func(t *testing.T) {
+		type S struct {
+			Value *string `json:"value" format:"late"`
+		}
+		assert.Error(t, g.InspectStruct(S{}))
+
+		RegisterFormat("late", func(string) bool { return true })
+		assert.NoError(t, g.InspectStruct(S{Value: toPtr("x")}))
+	})
+}
+
+func TestParseFormatTag(t *testing.T) {
+	name, params, err := parseFormatTag("email")
+	assert.NoError(t, err)
+	assert.Equal(t, "email", name)
+	assert.Nil(t, params)
+
+	name, params, err = parseFormatTag("card(visa, amex)")
+	assert.NoError(t, err)
+	assert.Equal(t, "card", name)
+	assert.Equal(t, []string{"visa", "amex"}, params)
+
+	_, _, err = parseFormatTag("card(visa")
+	assert.Error(t, err)
+}
@@ -0,0 +1,214 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// The `godantic` tag gathers a field's rules in one place, e.g.
+// `godantic:"required,min=3,max=50,format=email"`. Each key stands for the
+// tag of the same name, so both forms are validated by the same code and can
+// be mixed on a field. Lists that the legacy tags separate with commas, such
+// as enum values, use |; values holding commas outside of parentheses, like
+// some regular expressions, can be quoted: regex='^\d{1,3}$'.
+
+type godanticKey struct {
+	// tag is the legacy tag the key sets; flag is its value when the key is
+	// written without one, e.g. `required` for binding:"required".
+	tag  string
+	flag string
+	// list converts | separators to commas, group strips the parentheses
+	// around the rules of each, keys and values.
+	list  bool
+	group bool
+}
+
+var godanticKeys = map[string]godanticKey{
+	"required":      {tag: "binding", flag: "required"},
+	"ignore":        {tag: "binding", flag: "ignore"},
+	"binding":       {tag: "binding"},
+	"pass_empty":    {tag: "pass-empty", flag: "true"},
+	"allow_inf_nan": {tag: "allow_inf_nan", flag: "true"},
+	"unique":        {tag: "unique", flag: "true", list: true},
+	"past":          {tag: "time", flag: "past"},
+	"future":        {tag: "time", flag: "future"},
+	"enum":          {tag: "enum", list: true},
+	"currency":      {tag: "currency", list: true},
+	"validate":      {tag: "validate", list: true},
+	"each":          {tag: "each", group: true},
+	"keys":          {tag: "keys", group: true},
+	"values":        {tag: "values", group: true},
+}
+
+func init() {
+	for _, tag := range []string{
+		"min", "max", "gt", "ge", "lt", "le", "multiple_of", "max_digits", "decimal_places",
+		"regex", "format", "when", "time", "time_format", "after", "before", "max_age", "min_age",
+		"min_amount", "max_amount", "phone_region", "phone_type",
+	} {
+		godanticKeys[tag] = godanticKey{tag: tag}
+	}
+}
+
+// splitGodanticTag splits tag on the commas outside of parentheses and
+// quotes, returning each part with its offset in tag.
+func splitGodanticTag(tag string) (parts []string, offsets []int) {
+	depth, quoted, start := 0, false, 0
+	for i := 0; i <= len(tag); i++ {
+		if i < len(tag) {
+			switch c := tag[i]; {
+			case c == '\'':
+				quoted = !quoted
+			case quoted:
+			case c == '(':
+				depth++
+			case c == ')':
+				depth--
+			}
+			if tag[i] != ',' || depth > 0 || quoted {
+				continue
+			}
+		}
+		parts, offsets = append(parts, tag[start:i]), append(offsets, start)
+		start = i + 1
+	}
+	return parts, offsets
+}
+
+// pipesToCommas turns the | separators outside of parentheses into commas.
+func pipesToCommas(s string) string {
+	depth := 0
+	out := []byte(s)
+	for i, c := range out {
+		switch {
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			out[i] = ','
+		}
+	}
+	return string(out)
+}
+
+// groupPipesToCommas turns the | separators of the list rules inside a
+// group into commas, e.g. "enum=x|y,max=3" into "enum=x,y,max=3".
+func groupPipesToCommas(rules string) string {
+	parts, _ := splitGodanticTag(rules)
+	for i, part := range parts {
+		if name, value, ok := strings.Cut(part, "="); ok && godanticKeys[strings.TrimSpace(name)].list {
+			parts[i] = name + "=" + pipesToCommas(value)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// expandGodanticTag returns the field's tag with the legacy tags its
+// `godantic` tag stands for appended. Expanding an expanded tag is a no-op.
+func expandGodanticTag(f reflect.StructField) (reflect.StructTag, error) {
+	tag, ok := f.Tag.Lookup("godantic")
+	if !ok {
+		return f.Tag, nil
+	}
+	set := make(map[string]string)
+	var expanded []string
+	parts, offsets := splitGodanticTag(tag)
+	for i, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		name, value, hasValue := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+		key, known := godanticKeys[name]
+		switch {
+		case !known:
+			return "", fmt.Errorf("unknown key '%s' at offset %d", name, offsets[i])
+		case !hasValue && key.flag == "":
+			return "", fmt.Errorf("'%s' at offset %d needs a value, e.g. %s=...", name, offsets[i], name)
+		case hasValue && key.flag != "" && !key.list:
+			return "", fmt.Errorf("'%s' at offset %d takes no value", name, offsets[i])
+		}
+
+		value = strings.TrimSpace(value)
+		if !hasValue {
+			value = key.flag
+		}
+		if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		} else if key.list {
+			value = pipesToCommas(value)
+		}
+		if key.group {
+			if !strings.HasPrefix(value, "(") || !strings.HasSuffix(value, ")") {
+				return "", fmt.Errorf("'%s' at offset %d takes rules in parentheses, e.g. %s=(max=10)", name, offsets[i], name)
+			}
+			value = groupPipesToCommas(value[1 : len(value)-1])
+		}
+
+		if previous, ok := set[key.tag]; ok {
+			return "", fmt.Errorf("%s is set twice, to '%s' and '%s'", key.tag, previous, value)
+		}
+		if legacy, ok := f.Tag.Lookup(key.tag); ok && legacy != value {
+			return "", fmt.Errorf("%s='%s' conflicts with the %s tag '%s'", name, value, key.tag, legacy)
+		}
+		set[key.tag] = value
+		expanded = append(expanded, key.tag+":"+strconv.Quote(value))
+	}
+	if len(expanded) == 0 {
+		return f.Tag, nil
+	}
+	return reflect.StructTag(string(f.Tag) + " " + strings.Join(expanded, " ")), nil
+}
+
+// ExpandTag returns tag with its `godantic` tag expanded into the tags it
+// stands for, as the validator reads them. It is meant for tools that check
+// struct tags, such as godanticlint.
+func ExpandTag(tag reflect.StructTag) (reflect.StructTag, error) {
+	return expandGodanticTag(reflect.StructField{Tag: tag})
+}
+
+// checkGodanticConfig reports `godantic` tags that can't be parsed.
+func checkGodanticConfig(f reflect.StructField) string {
+	if _, err := expandGodanticTag(f); err != nil {
+		return fmt.Sprintf("godantic: %v", err)
+	}
+	return ""
+}
+
+// godanticFields caches the fields of each struct type with their
+// `godantic` tags expanded. Entries are outdated by typeConfigGeneration,
+// which For bumps when it sets a rule.
+var godanticFields sync.Map
+
+type cachedFields struct {
+	generation uint64
+	fields     []reflect.StructField
+}
+
+// structField returns the i-th field of struct type t, with the rules set
+// with For and its `godantic` tag expanded into the tags the field checks
+// read. Tags that can't be parsed are left as they are and reported by
+// checkGodanticConfig.
+func structField(t reflect.Type, i int) reflect.StructField {
+	// The generation is read before the rules, so fields built from rules
+	// that change meanwhile are stored as outdated.
+	generation := atomic.LoadUint64(&typeConfigGeneration)
+	if cached, ok := godanticFields.Load(t); ok && cached.(cachedFields).generation == generation {
+		return cached.(cachedFields).fields[i]
+	}
+	fields := make([]reflect.StructField, t.NumField())
+	for j := range fields {
+		fields[j] = t.Field(j)
+		fields[j].Tag = withTypeRules(t, j, fields[j].Tag)
+		if tag, err := expandGodanticTag(fields[j]); err == nil {
+			fields[j].Tag = tag
+		}
+	}
+	godanticFields.Store(t, cachedFields{generation: generation, fields: fields})
+	return fields[i]
+}
@@ -0,0 +1,112 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGodanticTag(t *testing.T) {
+	g := &Validate{}
+
+	type Legacy struct {
+		Name   *string   `json:"name" binding:"required" min:"3" max:"50"`
+		Email  *string   `json:"email" format:"email"`
+		Role   *string   `json:"role" enum:"admin,editor"`
+		Code   *string   `json:"code" regex:"^[A-Z]{2,3}$"`
+		Score  *float64  `json:"score" ge:"0" lt:"10" multiple_of:"0.5"`
+		Note   *string   `json:"note" pass-empty:"true"`
+		Emails *[]string `json:"emails" unique:"true" each:"format=email,max=30"`
+		Colors *[]string `json:"colors" each:"enum=red,green,max=5"`
+	}
+	type Unified struct {
+		Name   *string   `json:"name" godantic:"required,min=3,max=50"`
+		Email  *string   `json:"email" godantic:"format=email"`
+		Role   *string   `json:"role" godantic:"enum=admin|editor"`
+		Code   *string   `json:"code" godantic:"regex='^[A-Z]{2,3}$'"`
+		Score  *float64  `json:"score" godantic:"ge=0, lt=10, multiple_of=0.5"`
+		Note   *string   `json:"note" godantic:"pass_empty"`
+		Emails *[]string `json:"emails" godantic:"unique,each=(format=email,max=30)"`
+		Colors *[]string `json:"colors" godantic:"each=(enum=red|green,max=5)"`
+	}
+
+	payloads := []string{
+		`{"name": "Ana", "email": "ana@example.com", "role": "admin", "code": "MZ", "score": 9.5, "note": "", "emails": ["a@example.com"]}`,
+		`{"email": "ana@example.com"}`,
+		`{"name": "An"}`,
+		`{"name": "Ana", "email": "ana"}`,
+		`{"name": "Ana", "role": "root"}`,
+		`{"name": "Ana", "code": "MOZA"}`,
+		`{"name": "Ana", "score": 10}`,
+		`{"name": "Ana", "score": 1.2}`,
+		`{"name": "Ana", "emails": ["a@example.com", "a@example.com"]}`,
+		`{"name": "Ana", "emails": ["a@example"]}`,
+		`{"name": "Ana", "colors": ["red", "green"]}`,
+		`{"name": "Ana", "colors": ["blue"]}`,
+	}
+	for _, data := range payloads {
+		var legacy Legacy
+		var unified Unified
+		legacyErr := g.BindJSON([]byte(data), &legacy)
+		unifiedErr := g.BindJSON([]byte(data), &unified)
+		if legacyErr == nil {
+			assert.NoError(t, unifiedErr, data)
+			continue
+		}
+		if assert.Error(t, unifiedErr, data) {
+			assert.Equal(t, legacyErr.(*Error).ErrType, unifiedErr.(*Error).ErrType, data)
+			assert.Equal(t, legacyErr.(*Error).Path, unifiedErr.(*Error).Path, data)
+		}
+	}
+
+	t.Run("Lists inside groups", func(t *testing.T) {
+		tag, err := ExpandTag(`godantic:"each=(enum=x|y,validate=a|b),keys=(enum=k|v)"`)
+		assert.NoError(t, err)
+		assert.Equal(t, "enum=x,y,validate=a,b", tag.Get("each"))
+		assert.Equal(t, "enum=k,v", tag.Get("keys"))
+	})
+
+	t.Run("Mixed with legacy tags", func(t *testing.T) {
+		type Mixed struct {
+			Name *string `json:"name" godantic:"required" max:"5"`
+		}
+		var m Mixed
+		err := g.BindJSON([]byte(`{"name": "Anastasia"}`), &m)
+		assert.Error(t, err)
+		assert.Equal(t, "MAX_LENGTH_ERR", err.(*Error).ErrType)
+	})
+
+	testCases := []struct {
+		name    string
+		value   any
+		message string
+	}{
+		{"Unknown key", struct {
+			Name *string `json:"name" godantic:"required,mn=3"`
+		}{}, "unknown key 'mn' at offset 9"},
+		{"Missing value", struct {
+			Name *string `json:"name" godantic:"format"`
+		}{}, "'format' at offset 0 needs a value"},
+		{"Value on a flag", struct {
+			Name *string `json:"name" godantic:"required=yes"`
+		}{}, "'required' at offset 0 takes no value"},
+		{"Set twice", struct {
+			Name *string `json:"name" godantic:"min=1,min=2"`
+		}{}, "min is set twice"},
+		{"Conflicting legacy tag", struct {
+			Name *string `json:"name" godantic:"max=5" max:"6"`
+		}{}, "max='5' conflicts with the max tag '6'"},
+		{"Rules without parentheses", struct {
+			Name *[]string `json:"name" godantic:"each=max=3"`
+		}{}, "'each' at offset 0 takes rules in parentheses"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.value)
+			assert.Error(t, err)
+			assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+			assert.Equal(t, "name", err.(*Error).Path)
+			assert.Contains(t, err.(*Error).Message, tc.message)
+		})
+	}
+}
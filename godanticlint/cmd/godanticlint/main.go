@@ -0,0 +1,16 @@
+// Command godanticlint reports godantic struct tags that can never be
+// applied. It runs standalone or as a vet tool:
+//
+//	godanticlint ./...
+//	go vet -vettool=$(which godanticlint) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/grahms/godantic/godanticlint"
+)
+
+func main() {
+	singlechecker.Main(godanticlint.Analyzer)
+}
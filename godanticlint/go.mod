@@ -0,0 +1,15 @@
+module github.com/grahms/godantic/godanticlint
+
+go 1.26.0
+
+require (
+	github.com/grahms/godantic v0.0.0
+	golang.org/x/tools v0.51.0
+)
+
+require (
+	golang.org/x/mod v0.41.0 // indirect
+	golang.org/x/sync v0.23.0 // indirect
+)
+
+replace github.com/grahms/godantic => ../
@@ -0,0 +1,354 @@
+// Package godanticlint defines an analyzer that reports godantic struct
+// tags that can never work as written: bounds that aren't numbers, unknown
+// formats, regular expressions that don't compile, `when` conditions on
+// fields that don't exist and enums on fields that aren't strings.
+//
+// Only structs that reach BindJSON, BindJSONPartial, BindJSONPatch or
+// InspectStruct of a Validate or Validator in the analyzed package are
+// checked, together with the structs they contain. Run it standalone with cmd/godanticlint, through
+// `go vet -vettool`, or as a golangci-lint plugin with New.
+package godanticlint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/grahms/godantic"
+	_ "github.com/grahms/godantic/packs/br"
+	_ "github.com/grahms/godantic/packs/ke"
+	_ "github.com/grahms/godantic/packs/pt"
+	_ "github.com/grahms/godantic/packs/za"
+)
+
+const godanticPath = "github.com/grahms/godantic"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "godanticlint",
+	Doc:      "report godantic struct tags that can never be applied",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// extraFormats lists formats registered at runtime outside of the analyzed
+// package, e.g. in main.
+var extraFormats string
+
+func init() {
+	Analyzer.Flags.StringVar(&extraFormats, "formats", "", "comma-separated formats registered outside of the analyzed package")
+}
+
+// New returns the analyzers of this package, for golangci-lint plugins.
+func New(conf any) ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{Analyzer}, nil
+}
+
+// entryPoints maps the Validate and Validator methods that validate a
+// struct to the index of the argument holding it.
+var entryPoints = map[string]int{
+	"BindJSON":        1,
+	"BindJSONPartial": 1,
+	"BindJSONPatch":   1,
+	"InspectStruct":   0,
+}
+
+type linter struct {
+	pass     *analysis.Pass
+	formats  map[string]bool
+	reported map[string]bool
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	l := &linter{pass: pass, formats: knownFormats(pass.Pkg), reported: make(map[string]bool)}
+
+	var roots []ast.Expr
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != godanticPath {
+			return
+		}
+		if strings.HasPrefix(fn.Name(), "RegisterFormat") && len(call.Args) > 0 {
+			if tv := pass.TypesInfo.Types[call.Args[0]]; tv.Value != nil && tv.Value.Kind() == constant.String {
+				l.formats[constant.StringVal(tv.Value)] = true
+			}
+			return
+		}
+		if i, ok := entryPoints[fn.Name()]; ok && isValidateMethod(fn) && i < len(call.Args) {
+			roots = append(roots, call.Args[i])
+		}
+	})
+
+	for _, arg := range roots {
+		root := pass.TypesInfo.TypeOf(arg)
+		l.walk(root, root, arg.Pos(), make(map[types.Type]bool))
+	}
+	return nil, nil
+}
+
+func isValidateMethod(fn *types.Func) bool {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return false
+	}
+	named, ok := deref(recv.Type()).(*types.Named)
+	if !ok {
+		return false
+	}
+	name := named.Obj().Name()
+	return name == "Validate" || name == "Validator"
+}
+
+// knownFormats returns the formats of the godantic core, of the packs pkg
+// is or imports, and of the -formats flag.
+func knownFormats(pkg *types.Package) map[string]bool {
+	imported := map[string]bool{pkg.Path(): true}
+	var visit func(p *types.Package)
+	visit = func(p *types.Package) {
+		for _, imp := range p.Imports() {
+			if !imported[imp.Path()] {
+				imported[imp.Path()] = true
+				visit(imp)
+			}
+		}
+	}
+	visit(pkg)
+
+	packs := make(map[string]bool)
+	for _, country := range godantic.RegisteredPacks() {
+		packs[country] = true
+	}
+	formats := make(map[string]bool)
+	for _, name := range godantic.RegisteredFormats() {
+		if country, _, ok := strings.Cut(name, "-"); ok && packs[country] && !imported[godanticPath+"/packs/"+country] {
+			continue
+		}
+		formats[name] = true
+	}
+	for _, name := range strings.Split(extraFormats, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			formats[name] = true
+		}
+	}
+	return formats
+}
+
+func deref(t types.Type) types.Type {
+	for {
+		p, ok := t.(*types.Pointer)
+		if !ok {
+			return t
+		}
+		t = p.Elem()
+	}
+}
+
+// isLeaf reports whether t is validated as a whole rather than field by
+// field: time.Time and the godantic value types.
+func isLeaf(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	path := named.Obj().Pkg().Path()
+	return path == godanticPath || path == "time"
+}
+
+// walk checks the fields of the structs reachable from t. root is the
+// struct passed to godantic, which `when` paths start from.
+func (l *linter) walk(t, root types.Type, at token.Pos, seen map[types.Type]bool) {
+	t = deref(t)
+	if seen[t] || isLeaf(t) {
+		return
+	}
+	seen[t] = true
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		l.walk(u.Elem(), root, at, seen)
+	case *types.Array:
+		l.walk(u.Elem(), root, at, seen)
+	case *types.Map:
+		l.walk(u.Elem(), root, at, seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			f := u.Field(i)
+			if !f.Exported() {
+				continue
+			}
+			l.checkField(f, reflect.StructTag(u.Tag(i)), root, at)
+			l.walk(f.Type(), root, at, seen)
+		}
+	}
+}
+
+// report reports a problem on field f. Fields declared in other packages
+// are reported at the call that validates them.
+func (l *linter) report(f *types.Var, at token.Pos, format string, args ...any) {
+	message := fmt.Sprintf("field %s: %s", f.Name(), fmt.Sprintf(format, args...))
+	pos := f.Pos()
+	if f.Pkg() != l.pass.Pkg {
+		pos = at
+		message = fmt.Sprintf("%s (declared at %s)", message, l.pass.Fset.Position(f.Pos()))
+	}
+	key := fmt.Sprint(pos, message)
+	if l.reported[key] {
+		return
+	}
+	l.reported[key] = true
+	l.pass.Reportf(pos, "%s", message)
+}
+
+func basicInfo(t types.Type) types.BasicInfo {
+	if b, ok := deref(t).Underlying().(*types.Basic); ok {
+		return b.Info()
+	}
+	return 0
+}
+
+func (l *linter) checkField(f *types.Var, tag reflect.StructTag, root types.Type, at token.Pos) {
+	expanded, err := godantic.ExpandTag(tag)
+	if err != nil {
+		l.report(f, at, "godantic tag: %v", err)
+		expanded = tag
+	}
+	t := deref(f.Type())
+	info := basicInfo(t)
+	isList := false
+	switch t.Underlying().(type) {
+	case *types.Slice, *types.Array, *types.Map:
+		isList = true
+	}
+
+	if !isLeaf(t) {
+		for _, name := range []string{"min", "max"} {
+			bound, ok := expanded.Lookup(name)
+			if !ok {
+				continue
+			}
+			var err error
+			switch {
+			case info&types.IsString != 0 || isList:
+				_, err = strconv.Atoi(bound)
+			case info&types.IsUnsigned != 0:
+				_, err = strconv.ParseUint(bound, 10, 64)
+			case info&types.IsInteger != 0:
+				_, err = strconv.ParseInt(bound, 10, 64)
+			case info&types.IsFloat != 0:
+				_, err = strconv.ParseFloat(bound, 64)
+			}
+			if err != nil {
+				l.report(f, at, "%s:%q is not a valid bound for %s, it is ignored", name, bound, f.Type())
+			}
+		}
+		if info&types.IsNumeric != 0 {
+			for _, name := range []string{"gt", "ge", "lt", "le", "multiple_of"} {
+				if bound, ok := expanded.Lookup(name); ok {
+					if _, err := strconv.ParseFloat(bound, 64); err != nil {
+						l.report(f, at, "%s:%q is not a number, it is ignored", name, bound)
+					}
+				}
+			}
+		}
+	}
+	for _, name := range []string{"max_digits", "decimal_places"} {
+		if n, ok := expanded.Lookup(name); ok {
+			if _, err := strconv.Atoi(n); err != nil {
+				l.report(f, at, "%s:%q is not an integer", name, n)
+			}
+		}
+	}
+
+	if pattern, ok := expanded.Lookup("regex"); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			l.report(f, at, "regex does not compile: %v", err)
+		}
+	}
+	if format, ok := expanded.Lookup("format"); ok {
+		name := strings.TrimSpace(format)
+		if open := strings.Index(name, "("); open != -1 {
+			name = strings.TrimSpace(name[:open])
+		}
+		if !l.formats[name] {
+			l.report(f, at, "unknown format %q", name)
+		}
+	}
+	for _, name := range []string{"enum", "enums"} {
+		if _, ok := expanded.Lookup(name); ok && info&types.IsString == 0 {
+			l.report(f, at, "%s only applies to strings, a %s never matches", name, f.Type())
+		}
+	}
+	if condition, ok := expanded.Lookup("when"); ok {
+		l.checkCondition(f, condition, root, at)
+	}
+}
+
+// checkCondition checks that the paths of a `when` condition lead from the
+// root to a string field with an enum tag, the only fields conditions see.
+func (l *linter) checkCondition(f *types.Var, condition string, root types.Type, at token.Pos) {
+	for _, part := range strings.Split(condition, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" || strings.HasPrefix(part, "binding=") {
+			continue
+		}
+		operator := strings.IndexAny(part, "=><!")
+		if operator == -1 {
+			continue
+		}
+		path := strings.TrimSpace(part[:operator])
+		target, tag, ok := resolvePath(root, path)
+		switch {
+		case !ok:
+			l.report(f, at, "when refers to %q, which is not a field of %s", path, deref(root))
+		case basicInfo(target.Type())&types.IsString == 0:
+			l.report(f, at, "when refers to %q, which is not a string", path)
+		default:
+			if _, hasEnum := tag.Lookup("enum"); !hasEnum {
+				l.report(f, at, "when refers to %q, which has no enum tag, so the condition is never met", path)
+			}
+		}
+	}
+}
+
+// resolvePath follows a dotted path of JSON names through nested structs.
+func resolvePath(t types.Type, path string) (*types.Var, reflect.StructTag, bool) {
+	var field *types.Var
+	var tag reflect.StructTag
+	for _, name := range strings.Split(path, ".") {
+		st, ok := deref(t).Underlying().(*types.Struct)
+		if !ok {
+			return nil, "", false
+		}
+		field = nil
+		for i := 0; i < st.NumFields(); i++ {
+			candidate := reflect.StructTag(st.Tag(i))
+			if expanded, err := godantic.ExpandTag(candidate); err == nil {
+				candidate = expanded
+			}
+			jsonName := strings.Split(candidate.Get("json"), ",")[0]
+			if jsonName == "" {
+				jsonName = st.Field(i).Name()
+			}
+			if jsonName == name {
+				field, tag = st.Field(i), candidate
+				break
+			}
+		}
+		if field == nil {
+			return nil, "", false
+		}
+		t = field.Type()
+	}
+	return field, tag, field != nil
+}
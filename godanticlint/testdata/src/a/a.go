@@ -0,0 +1,61 @@
+package a
+
+import "github.com/grahms/godantic"
+
+type Context struct {
+	Type  *string `json:"type" enum:"individual,organization"`
+	Label *string `json:"label"`
+	Size  *int    `json:"size"`
+}
+
+type Item struct {
+	SKU *string `json:"sku" format:"colour"` // want `field SKU: unknown format "colour"`
+}
+
+type Request struct {
+	Context Context `json:"context"`
+
+	Name    *string  `json:"name" min:"abc" max:"50"` // want `field Name: min:"abc" is not a valid bound for \*string, it is ignored`
+	Email   *string  `json:"email" format:"email"`
+	Phone   *string  `json:"phone" format:"phone(MZ)"`
+	ID      *string  `json:"id" format:"za-id"` // want `field ID: unknown format "za-id"`
+	Slug    *string  `json:"slug" format:"slug"`
+	Code    *string  `json:"code" regex:"^[A-Z+$"` // want `field Code: regex does not compile: .*`
+	Count   *int     `json:"count" enum:"1,2"`     // want `field Count: enum only applies to strings, a \*int never matches`
+	Ratio   *float64 `json:"ratio" gt:"zero"`      // want `field Ratio: gt:"zero" is not a number, it is ignored`
+	Port    *uint16  `json:"port" max:"-1"`        // want `field Port: max:"-1" is not a valid bound for \*uint16, it is ignored`
+	Tags    *[]Item  `json:"tags" max:"3"`
+	RegNo   *string  `json:"reg_no" when:"context.type=organization;binding=required"`
+	Missing *string  `json:"missing" when:"context.kind=organization;binding=required"` // want `field Missing: when refers to "context.kind", which is not a field of a.Request`
+	NoEnum  *string  `json:"no_enum" when:"context.label=x;binding=required"`           // want `field NoEnum: when refers to "context.label", which has no enum tag, so the condition is never met`
+	NotStr  *string  `json:"not_str" when:"context.size=1;binding=required"`            // want `field NotStr: when refers to "context.size", which is not a string`
+	Unified *string  `json:"unified" godantic:"required,mn=3"`                          // want `field Unified: godantic tag: unknown key 'mn' at offset 9`
+	Bad     *int     `json:"bad" godantic:"min=ten"`                                    // want `field Bad: min:"ten" is not a valid bound for \*int, it is ignored`
+}
+
+// Order is only validated through a Validator.
+type Order struct {
+	Total *float64 `json:"total" ge:"none"` // want `field Total: ge:"none" is not a number, it is ignored`
+}
+
+// Unchecked never reaches godantic, so its tags are not reported.
+type Unchecked struct {
+	Name *string `json:"name" min:"abc"`
+}
+
+func init() {
+	godantic.RegisterFormat("slug", func(string) bool { return true })
+}
+
+func handle(data []byte) error {
+	var r Request
+	g := &godantic.Validate{}
+	return g.BindJSON(data, &r)
+}
+
+var validator = godantic.New()
+
+func handleOrder(data []byte) error {
+	var o Order
+	return validator.BindJSONPatch(data, &o)
+}
@@ -0,0 +1,25 @@
+// Package godantic is a stub of the real package for the analyzer tests.
+package godantic
+
+type Validate struct{}
+
+func (g *Validate) BindJSON(jsonData []byte, obj any) error        { return nil }
+func (g *Validate) BindJSONPartial(jsonData []byte, obj any) error { return nil }
+func (g *Validate) BindJSONPatch(patchData []byte, obj any) error  { return nil }
+func (g *Validate) InspectStruct(val any) error                    { return nil }
+
+type Option func(*Validate)
+
+type Schema struct{}
+
+type Validator struct{}
+
+func New(opts ...Option) *Validator { return &Validator{} }
+
+func (v *Validator) BindJSON(jsonData []byte, obj any) error             { return nil }
+func (v *Validator) BindJSONPartial(jsonData []byte, obj any) error      { return nil }
+func (v *Validator) BindJSONPatch(patchData []byte, obj any) error       { return nil }
+func (v *Validator) InspectStruct(val any) error                         { return nil }
+func (v *Validator) ValidateMap(s *Schema, payload map[string]any) error { return nil }
+
+func RegisterFormat(name string, fn func(string) bool) {}
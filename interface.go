@@ -24,7 +24,9 @@ func resolveInterface[T any](val reflect.Value) (T, bool) {
 	return zero, false
 }
 
-func (g *Validate) validateInterfaceHooks(val any, path string) *Error {
+// validateInterfaceHooks runs the plugin hooks on val and the struct
+// validator registered for v, the struct being checked.
+func (g *Validate) validateInterfaceHooks(val any, v reflect.Value, path string) *Error {
 	rv := reflect.ValueOf(val)
 
 	// ValidationPlugin hook
@@ -49,5 +51,6 @@ func (g *Validate) validateInterfaceHooks(val any, path string) *Error {
 		}
 	}
 
-	return nil
+	// Struct validators registered by type
+	return g.validateStruct(v, path)
 }
@@ -0,0 +1,178 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Money is an exact amount in an ISO 4217 currency. It binds from either
+// {"amount": "10.50", "currency": "MZN"}, with the amount as a string or a
+// number, or the string "10.50 MZN", and marshals to the object form.
+//
+// Validation checks the currency against the embedded ISO 4217 table and
+// the amount against the currency's minor units, so 10.5 is a valid MZN
+// amount but 10.5 JPY is not. The tags `currency:"MZN,USD"`,
+// `min_amount:"1.00"` and `max_amount:"500000"` narrow it further.
+type Money struct {
+	Amount   Decimal
+	Currency string
+}
+
+// ParseMoney parses money in the form "10.50 MZN".
+func ParseMoney(s string) (Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Money{}, fmt.Errorf("'%s' is not an amount followed by a currency", s)
+	}
+	amount, err := ParseDecimal(fields[0])
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: fields[1]}, nil
+}
+
+// CurrencyMinorUnits returns the number of decimal places of an ISO 4217
+// currency, e.g. 2 for MZN and 0 for JPY.
+func CurrencyMinorUnits(currency string) (int, bool) {
+	units, ok := currencyMinorUnits[currency]
+	return units, ok
+}
+
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency
+}
+
+type moneyJSON struct {
+	Amount   *Decimal `json:"amount"`
+	Currency string   `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: &m.Amount, Currency: m.Currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	switch {
+	case s == "null":
+		return nil
+	case strings.HasPrefix(s, `"`):
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		parsed, err := ParseMoney(s)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+	var aux moneyJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.Amount == nil || aux.Currency == "" {
+		return fmt.Errorf("money needs both an amount and a currency")
+	}
+	*m = Money{Amount: *aux.Amount, Currency: aux.Currency}
+	return nil
+}
+
+var moneyType = reflect.TypeOf(Money{})
+
+// validMoney reports whether a decoded JSON value is in one of the forms
+// Money binds from. The currency itself is checked by checkMoney.
+func validMoney(raw any) bool {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+	return new(Money).UnmarshalJSON(data) == nil
+}
+
+func (g *Validate) checkMoney(f reflect.StructField, v reflect.Value, tree string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Type() != moneyType {
+		return nil
+	}
+	m := v.Interface().(Money)
+	path := fieldName(f, tree)
+
+	units, ok := CurrencyMinorUnits(m.Currency)
+	if !ok {
+		return &Error{
+			ErrType: "INVALID_CURRENCY_ERR",
+			Path:    path,
+			Message: fmt.Sprintf("The field <%s> has an unknown currency '%s'", path, m.Currency),
+		}
+	}
+	if allowed := f.Tag.Get("currency"); allowed != "" && !containsCode(allowed, m.Currency) {
+		return &Error{
+			ErrType: "INVALID_CURRENCY_ERR",
+			Path:    path,
+			Message: fmt.Sprintf("The field <%s> must be in one of the following currencies: %s, '%s' was given", path, allowed, m.Currency),
+		}
+	}
+	if _, places := m.Amount.Digits(); places > units {
+		return &Error{
+			ErrType: "DECIMAL_PLACES_ERR",
+			Path:    path,
+			Message: fmt.Sprintf("The field <%s> must have at most %d decimal places for %s (got %d)", path, units, m.Currency, places),
+		}
+	}
+	if tag := f.Tag.Get("min_amount"); tag != "" {
+		if bound, err := ParseDecimal(tag); err == nil && m.Amount.Cmp(bound) < 0 {
+			return &Error{
+				ErrType: "MIN_VALUE_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be at least %s %s, but was %s", path, bound, m.Currency, m),
+			}
+		}
+	}
+	if tag := f.Tag.Get("max_amount"); tag != "" {
+		if bound, err := ParseDecimal(tag); err == nil && m.Amount.Cmp(bound) > 0 {
+			return &Error{
+				ErrType: "MAX_VALUE_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be at most %s %s, but was %s", path, bound, m.Currency, m),
+			}
+		}
+	}
+	return nil
+}
+
+func containsCode(list, code string) bool {
+	for _, c := range strings.Split(list, ",") {
+		if strings.TrimSpace(c) == code {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMoneyConfig reports unknown currencies in the `currency` tag and
+// amounts that aren't decimals.
+func checkMoneyConfig(f reflect.StructField) string {
+	if allowed, ok := f.Tag.Lookup("currency"); ok {
+		for _, c := range strings.Split(allowed, ",") {
+			if _, known := CurrencyMinorUnits(strings.TrimSpace(c)); !known {
+				return fmt.Sprintf("currency: unknown ISO 4217 code '%s'", strings.TrimSpace(c))
+			}
+		}
+	}
+	for _, tag := range []string{"min_amount", "max_amount"} {
+		if amount, ok := f.Tag.Lookup(tag); ok {
+			if _, err := ParseDecimal(amount); err != nil {
+				return fmt.Sprintf("%s: %v", tag, err)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package godantic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyJSON(t *testing.T) {
+	for _, data := range []string{
+		`"10.50 MZN"`,
+		`{"amount": "10.50", "currency": "MZN"}`,
+		`{"amount": 10.50, "currency": "MZN"}`,
+	} {
+		var m Money
+		assert.NoError(t, json.Unmarshal([]byte(data), &m), data)
+		assert.Equal(t, 0, m.Amount.Cmp(MustParseDecimal("10.5")), data)
+		assert.Equal(t, "MZN", m.Currency, data)
+	}
+
+	out, err := json.Marshal(Money{Amount: MustParseDecimal("10.50"), Currency: "MZN"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount": "10.50", "currency": "MZN"}`, string(out))
+
+	for _, data := range []string{`"10.50"`, `"MZN 10.50"`, `"ten MZN"`, `{"amount": "10.50"}`, `{"currency": "MZN"}`, `10.5`} {
+		var m Money
+		assert.Error(t, json.Unmarshal([]byte(data), &m), data)
+	}
+}
+
+func TestMoneyBinding(t *testing.T) {
+	g := &Validate{}
+
+	type Transfer struct {
+		Amount Money  `json:"amount" binding:"required" currency:"MZN,USD,ZAR,JPY,BHD" min_amount:"1.00" max_amount:"500000"`
+		Fee    *Money `json:"fee"`
+	}
+
+	validCases := []string{
+		`{"amount": "10.50 MZN"}`,
+		`{"amount": {"amount": "1500", "currency": "JPY"}}`,
+		`{"amount": {"amount": 2.125, "currency": "BHD"}}`,
+		`{"amount": "10.500 USD", "fee": "0.01 EUR"}`,
+	}
+	for _, data := range validCases {
+		var tr Transfer
+		assert.NoError(t, g.BindJSON([]byte(data), &tr), data)
+	}
+
+	testCases := []struct {
+		name    string
+		data    string
+		errType string
+	}{
+		{"Missing amount", `{"fee": "1 USD"}`, "REQUIRED_FIELD_ERR"},
+		{"Malformed string", `{"amount": "10.50"}`, "INVALID_MONEY_ERR"},
+		{"Malformed object", `{"amount": {"amount": "ten", "currency": "MZN"}}`, "INVALID_MONEY_ERR"},
+		{"Unknown currency", `{"amount": "10 XYZ"}`, "INVALID_CURRENCY_ERR"},
+		{"Lower-case currency", `{"amount": "10 mzn"}`, "INVALID_CURRENCY_ERR"},
+		{"Currency not allowed", `{"amount": "10 EUR"}`, "INVALID_CURRENCY_ERR"},
+		{"Cents on yen", `{"amount": "10.5 JPY"}`, "DECIMAL_PLACES_ERR"},
+		{"Too many places for MZN", `{"amount": "10.505 MZN"}`, "DECIMAL_PLACES_ERR"},
+		{"Below min_amount", `{"amount": "0.99 USD"}`, "MIN_VALUE_ERR"},
+		{"Above max_amount", `{"amount": "500000.01 ZAR"}`, "MAX_VALUE_ERR"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var tr Transfer
+			err := g.BindJSON([]byte(tc.data), &tr)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, "amount", err.(*Error).Path)
+		})
+	}
+
+	t.Run("Unknown currency in the tag", func(t *testing.T) {
+		type BadCurrency struct {
+			Price *Money `json:"price" currency:"MZN,XYZ"`
+		}
+		err := g.InspectStruct(BadCurrency{})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "price", err.(*Error).Path)
+	})
+}
@@ -0,0 +1,98 @@
+package godantic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// unmarshalJSON decodes data like json.Unmarshal, but keeps numbers as
+// json.Number so large integers and decimals survive untouched.
+func unmarshalJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// isIntegerValue reports whether v is a whole number: a Go integer, an
+// integral float, or a json.Number without a fractional part, of any size.
+func isIntegerValue(v any) bool {
+	switch n := v.(type) {
+	case json.Number:
+		d, err := ParseDecimal(n.String())
+		if err != nil {
+			return false
+		}
+		_, places := d.Digits()
+		return places == 0
+	case float32:
+		return isIntegralFloat(float64(n))
+	case float64:
+		return isIntegralFloat(n)
+	}
+	rv := reflect.ValueOf(v)
+	return rv.IsValid() && rv.Kind() >= reflect.Int && rv.Kind() <= reflect.Uintptr
+}
+
+func isIntegralFloat(f float64) bool {
+	return !math.IsInf(f, 0) && !math.IsNaN(f) && f == math.Trunc(f)
+}
+
+// isFloatValue reports whether v is a floating point value or a JSON number.
+func isFloatValue(v any) bool {
+	switch n := v.(type) {
+	case float32, float64:
+		return true
+	case json.Number:
+		_, err := ParseDecimal(n.String())
+		return err == nil
+	}
+	return false
+}
+
+// numberRangeError turns a decode error for a number that is well-formed
+// for the target type, but doesn't fit in it, into a RANGE_ERR, e.g. 300
+// for an int8 or -1 for a uint16. It returns nil for other errors.
+func numberRangeError(e *json.UnmarshalTypeError) error {
+	literal := strings.TrimPrefix(e.Value, "number ")
+	if literal == e.Value || e.Type == nil {
+		return nil
+	}
+	d, err := ParseDecimal(literal)
+	if err != nil {
+		return nil
+	}
+	t := e.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var bounds string
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if _, places := d.Digits(); places > 0 {
+			return nil
+		}
+		bits := t.Bits()
+		bounds = fmt.Sprintf("%d to %d", int64(-1)<<(bits-1), int64(uint64(1)<<(bits-1)-1))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if _, places := d.Digits(); places > 0 {
+			return nil
+		}
+		bounds = fmt.Sprintf("0 to %d", uint64(math.MaxUint64)>>(64-t.Bits()))
+	case reflect.Float32:
+		bounds = fmt.Sprintf("±%g", math.MaxFloat32)
+	case reflect.Float64:
+		bounds = fmt.Sprintf("±%g", math.MaxFloat64)
+	default:
+		return nil
+	}
+	return &Error{
+		ErrType: "RANGE_ERR",
+		Path:    e.Field,
+		Message: fmt.Sprintf("The field <%s> must fit in %s (%s), %s was given", e.Field, t.Kind(), bounds, literal),
+	}
+}
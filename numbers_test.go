@@ -0,0 +1,105 @@
+package godantic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberRangeErrors(t *testing.T) {
+	g := &Validate{}
+
+	type Limits struct {
+		Small  *int8    `json:"small"`
+		Port   *uint16  `json:"port"`
+		Big    *int64   `json:"big"`
+		Ratio  *float32 `json:"ratio"`
+		Amount *int     `json:"amount"`
+	}
+
+	var l Limits
+	err := g.BindJSON([]byte(`{"small": -128, "port": 65535, "big": 9223372036854775807, "ratio": 0.5}`), &l)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9223372036854775807), *l.Big)
+
+	testCases := []struct {
+		name    string
+		data    string
+		errType string
+		path    string
+	}{
+		{"int8 overflow", `{"small": 300}`, "RANGE_ERR", "small"},
+		{"uint16 overflow", `{"port": 65536}`, "RANGE_ERR", "port"},
+		{"negative uint", `{"port": -1}`, "RANGE_ERR", "port"},
+		{"int64 overflow", `{"big": 9223372036854775808}`, "RANGE_ERR", "big"},
+		{"float32 overflow", `{"ratio": 1e39}`, "RANGE_ERR", "ratio"},
+		{"fraction for an int", `{"amount": 1.5}`, "TYPE_MISMATCH_ERR", "amount"},
+		{"string for an int", `{"amount": "1"}`, "TYPE_MISMATCH_ERR", "amount"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var l Limits
+			err := g.BindJSON([]byte(tc.data), &l)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+}
+
+func TestDynamicFieldNumbers(t *testing.T) {
+	g := &Validate{}
+
+	t.Run("Large integer IDs keep their digits", func(t *testing.T) {
+		var f MyDynamicField
+		err := g.BindJSON([]byte(`{"value": 12345678901234567890, "valueType": "numeric", "attribute": "id"}`), &f)
+		assert.NoError(t, err)
+		assert.Equal(t, json.Number("12345678901234567890"), f.Value)
+	})
+
+	t.Run("Numbers in maps keep their digits", func(t *testing.T) {
+		var p struct {
+			Extra map[string]any `json:"extra"`
+		}
+		err := g.BindJSON([]byte(`{"extra": {"id": 12345678901234567891}}`), &p)
+		assert.NoError(t, err)
+		assert.Equal(t, json.Number("12345678901234567891"), p.Extra["id"])
+	})
+
+	t.Run("Integral literal with an exponent", func(t *testing.T) {
+		var f MyDynamicField
+		assert.NoError(t, g.BindJSON([]byte(`{"value": 1e3, "valueType": "numeric", "attribute": "n"}`), &f))
+	})
+
+	t.Run("Fraction is not numeric", func(t *testing.T) {
+		var f MyDynamicField
+		err := g.BindJSON([]byte(`{"value": 1.5, "valueType": "numeric", "attribute": "n"}`), &f)
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_VALUE_TYPE_ERR", err.(*Error).ErrType)
+	})
+
+	t.Run("Float accepts any number", func(t *testing.T) {
+		var f MyDynamicField
+		assert.NoError(t, g.BindJSON([]byte(`{"value": 3, "valueType": "float", "attribute": "x"}`), &f))
+		assert.NoError(t, g.BindJSON([]byte(`{"value": 0.1, "valueType": "float", "attribute": "x"}`), &f))
+	})
+}
+
+func TestPatchNumbers(t *testing.T) {
+	g := &Validate{}
+
+	type Account struct {
+		ID      *int64   `json:"id"`
+		Balance *float64 `json:"balance"`
+	}
+
+	a := Account{ID: toPtr(int64(9007199254740993)), Balance: toPtr(1.0)}
+	err := g.BindJSONPatch([]byte(`[
+		{"op": "test", "path": "/balance", "value": 1.00},
+		{"op": "replace", "path": "/balance", "value": 2.5}
+	]`), &a)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), *a.ID)
+	assert.Equal(t, 2.5, *a.Balance)
+}
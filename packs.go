@@ -0,0 +1,55 @@
+package godantic
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FormatPack groups the national identifier formats of one country. Each
+// format is registered as "<country>-<name>", e.g. Country "za" with a
+// format named "id" is used as `format:"za-id"`.
+//
+// Packs for individual countries live in the packs/ subpackages and
+// register themselves when imported:
+//
+//	import _ "github.com/grahms/godantic/packs/za"
+type FormatPack struct {
+	// Country is the lowercase ISO 3166-1 alpha-2 code of the country.
+	Country string
+	// Formats maps format names, without the country prefix, to validators.
+	Formats map[string]FormatFunc
+}
+
+var (
+	packs    = make(map[string]FormatPack)
+	packsMux sync.RWMutex
+)
+
+// RegisterPack registers every format of pack in the format registry.
+// Registering a pack for the same country again replaces the formats of the
+// same name; formats the new pack doesn't have stay registered.
+func RegisterPack(pack FormatPack) {
+	country := strings.ToLower(pack.Country)
+
+	packsMux.Lock()
+	packs[country] = pack
+	packsMux.Unlock()
+
+	for name, fn := range pack.Formats {
+		RegisterFormatWithParams(country+"-"+name, fn)
+	}
+}
+
+// RegisteredPacks returns the countries with a registered pack, sorted.
+func RegisteredPacks() []string {
+	packsMux.RLock()
+	defer packsMux.RUnlock()
+
+	countries := make([]string, 0, len(packs))
+	for country := range packs {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+	return countries
+}
@@ -0,0 +1,91 @@
+// Package br registers Brazilian identifier formats with godantic.
+//
+//	import _ "github.com/grahms/godantic/packs/br"
+//
+//	type Company struct {
+//		CNPJ string `json:"cnpj" format:"br-cnpj"`
+//	}
+package br
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/grahms/godantic"
+)
+
+var (
+	cpfRegex  = regexp.MustCompile(`^(\d{11}|\d{3}\.\d{3}\.\d{3}-\d{2})$`)
+	cnpjRegex = regexp.MustCompile(`^([0-9A-Z]{12}\d{2}|[0-9A-Z]{2}\.[0-9A-Z]{3}\.[0-9A-Z]{3}/[0-9A-Z]{4}-\d{2})$`)
+
+	separators = strings.NewReplacer(".", "", "-", "", "/", "")
+)
+
+// ValidCPF reports whether s is a CPF, either as 11 digits or formatted as
+// 000.000.000-00, with both check digits correct. Numbers made of a single
+// repeated digit are rejected.
+func ValidCPF(s string) bool {
+	if !cpfRegex.MatchString(s) {
+		return false
+	}
+	cpf := separators.Replace(s)
+	if strings.Count(cpf, cpf[:1]) == len(cpf) {
+		return false
+	}
+	for n := 9; n <= 10; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += int(cpf[i]-'0') * (n + 1 - i)
+		}
+		check := sum * 10 % 11
+		if check == 10 {
+			check = 0
+		}
+		if int(cpf[n]-'0') != check {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidCNPJ reports whether s is a CNPJ, either as 14 characters or
+// formatted as 00.000.000/0000-00, with both check digits correct. The
+// alphanumeric CNPJ is supported: the first 12 characters may be uppercase
+// letters, which count as their ASCII code minus 48.
+func ValidCNPJ(s string) bool {
+	if !cnpjRegex.MatchString(s) {
+		return false
+	}
+	cnpj := separators.Replace(s)
+	if strings.Count(cnpj, cnpj[:1]) == len(cnpj) {
+		return false
+	}
+	for n := 12; n <= 13; n++ {
+		sum := 0
+		weight := n - 7
+		for i := 0; i < n; i++ {
+			sum += int(cnpj[i]-'0') * weight
+			if weight--; weight < 2 {
+				weight = 9
+			}
+		}
+		check := 11 - sum%11
+		if check >= 10 {
+			check = 0
+		}
+		if int(cnpj[n]-'0') != check {
+			return false
+		}
+	}
+	return true
+}
+
+func init() {
+	godantic.RegisterPack(godantic.FormatPack{
+		Country: "br",
+		Formats: map[string]godantic.FormatFunc{
+			"cpf":  func(s string, _ []string) bool { return ValidCPF(s) },
+			"cnpj": func(s string, _ []string) bool { return ValidCNPJ(s) },
+		},
+	})
+}
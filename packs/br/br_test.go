@@ -0,0 +1,36 @@
+package br
+
+import (
+	"testing"
+
+	"github.com/grahms/godantic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidCPF(t *testing.T) {
+	assert.True(t, ValidCPF("52998224725"))
+	assert.True(t, ValidCPF("529.982.247-25"))
+	assert.False(t, ValidCPF("529.982.247-26"), "bad check digit")
+	assert.False(t, ValidCPF("111.111.111-11"), "repeated digits")
+	assert.False(t, ValidCPF("529982247-25"), "partially formatted")
+}
+
+func TestValidCNPJ(t *testing.T) {
+	assert.True(t, ValidCNPJ("11222333000181"))
+	assert.True(t, ValidCNPJ("11.222.333/0001-81"))
+	assert.True(t, ValidCNPJ("12.ABC.345/01DE-35"), "alphanumeric CNPJ")
+	assert.False(t, ValidCNPJ("11.222.333/0001-82"), "bad check digit")
+	assert.False(t, ValidCNPJ("00000000000000"), "repeated digits")
+}
+
+func TestFormatTags(t *testing.T) {
+	type Company struct {
+		CPF  *string `json:"cpf" format:"br-cpf"`
+		CNPJ *string `json:"cnpj" format:"br-cnpj"`
+	}
+	cpf, cnpj, bad := "529.982.247-25", "11.222.333/0001-81", "11.222.333/0001-80"
+
+	g := &godantic.Validate{}
+	assert.NoError(t, g.InspectStruct(Company{CPF: &cpf, CNPJ: &cnpj}))
+	assert.Error(t, g.InspectStruct(Company{CNPJ: &bad}))
+}
@@ -0,0 +1,33 @@
+// Package ke registers Kenyan identifier formats with godantic.
+//
+//	import _ "github.com/grahms/godantic/packs/ke"
+//
+//	type Supplier struct {
+//		PIN string `json:"pin" format:"ke-kra-pin"`
+//	}
+package ke
+
+import (
+	"regexp"
+
+	"github.com/grahms/godantic"
+)
+
+var kraPINRegex = regexp.MustCompile(`^[AP]\d{9}[A-Z]$`)
+
+// ValidKRAPIN reports whether s has the structure of a Kenya Revenue
+// Authority PIN: A (individuals) or P (non-individuals), 9 digits and a
+// letter. KRA does not publish a check digit algorithm, so only the
+// structure is verified.
+func ValidKRAPIN(s string) bool {
+	return kraPINRegex.MatchString(s)
+}
+
+func init() {
+	godantic.RegisterPack(godantic.FormatPack{
+		Country: "ke",
+		Formats: map[string]godantic.FormatFunc{
+			"kra-pin": func(s string, _ []string) bool { return ValidKRAPIN(s) },
+		},
+	})
+}
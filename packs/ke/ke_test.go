@@ -0,0 +1,27 @@
+package ke
+
+import (
+	"testing"
+
+	"github.com/grahms/godantic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidKRAPIN(t *testing.T) {
+	assert.True(t, ValidKRAPIN("A123456789Z"))
+	assert.True(t, ValidKRAPIN("P051234567M"))
+	assert.False(t, ValidKRAPIN("B123456789Z"), "unknown taxpayer type")
+	assert.False(t, ValidKRAPIN("A12345678Z"), "too short")
+	assert.False(t, ValidKRAPIN("a123456789z"), "lowercase")
+}
+
+func TestKRAPINFormatTag(t *testing.T) {
+	type Supplier struct {
+		PIN *string `json:"pin" format:"ke-kra-pin"`
+	}
+	valid, invalid := "P051234567M", "X051234567M"
+
+	g := &godantic.Validate{}
+	assert.NoError(t, g.InspectStruct(Supplier{PIN: &valid}))
+	assert.Error(t, g.InspectStruct(Supplier{PIN: &invalid}))
+}
@@ -0,0 +1,64 @@
+// Package pt registers Portuguese identifier formats with godantic.
+//
+//	import _ "github.com/grahms/godantic/packs/pt"
+//
+//	type Taxpayer struct {
+//		NIF string `json:"nif" format:"pt-nif"`
+//	}
+package pt
+
+import (
+	"strings"
+
+	"github.com/grahms/godantic"
+)
+
+// nifPrefixes holds the leading digits a NIF can start with.
+var nifPrefixes = []string{
+	"1", "2", "3", "5", "6", "8",
+	"45", "70", "71", "72", "74", "75", "77", "78", "79", "90", "91", "98", "99",
+}
+
+// ValidNIF reports whether s is a Portuguese tax number (NIF): 9 digits with
+// a known prefix, whose last digit is a mod-11 check digit over the first 8,
+// weighted 9 down to 2.
+func ValidNIF(s string) bool {
+	if len(s) != 9 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	if !hasNIFPrefix(s) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(s[i]-'0') * (9 - i)
+	}
+	check := 11 - sum%11
+	if check >= 10 {
+		check = 0
+	}
+	return int(s[8]-'0') == check
+}
+
+func hasNIFPrefix(s string) bool {
+	for _, prefix := range nifPrefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	godantic.RegisterPack(godantic.FormatPack{
+		Country: "pt",
+		Formats: map[string]godantic.FormatFunc{
+			"nif": func(s string, _ []string) bool { return ValidNIF(s) },
+		},
+	})
+}
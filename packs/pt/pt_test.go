@@ -0,0 +1,27 @@
+package pt
+
+import (
+	"testing"
+
+	"github.com/grahms/godantic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidNIF(t *testing.T) {
+	assert.True(t, ValidNIF("123456789"))
+	assert.True(t, ValidNIF("501964843"))
+	assert.False(t, ValidNIF("123456788"), "bad check digit")
+	assert.False(t, ValidNIF("412345678"), "unknown prefix")
+	assert.False(t, ValidNIF("12345678"), "too short")
+}
+
+func TestNIFFormatTag(t *testing.T) {
+	type Taxpayer struct {
+		NIF *string `json:"nif" format:"pt-nif"`
+	}
+	valid, invalid := "123456789", "123456780"
+
+	g := &godantic.Validate{}
+	assert.NoError(t, g.InspectStruct(Taxpayer{NIF: &valid}))
+	assert.Error(t, g.InspectStruct(Taxpayer{NIF: &invalid}))
+}
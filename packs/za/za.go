@@ -0,0 +1,52 @@
+// Package za registers South African identifier formats with godantic.
+//
+//	import _ "github.com/grahms/godantic/packs/za"
+//
+//	type Citizen struct {
+//		IDNumber string `json:"id_number" format:"za-id"`
+//	}
+package za
+
+import (
+	"time"
+
+	"github.com/grahms/godantic"
+)
+
+// ValidID reports whether s is a South African identity number: 13 digits
+// made of a YYMMDD date of birth, a 4-digit sequence, a citizenship digit
+// (0 citizen, 1 permanent resident, 2 refugee), one more digit and a Luhn
+// check digit.
+func ValidID(s string) bool {
+	if len(s) != 13 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	if !validBirthDate(s[:6]) || s[10] > '2' {
+		return false
+	}
+	return godantic.ValidLuhn(s)
+}
+
+// validBirthDate checks a YYMMDD date in either the 1900s or the 2000s.
+func validBirthDate(yymmdd string) bool {
+	for _, century := range []string{"19", "20"} {
+		if _, err := time.Parse("20060102", century+yymmdd); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	godantic.RegisterPack(godantic.FormatPack{
+		Country: "za",
+		Formats: map[string]godantic.FormatFunc{
+			"id": func(s string, _ []string) bool { return ValidID(s) },
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package za
+
+import (
+	"testing"
+
+	"github.com/grahms/godantic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidID(t *testing.T) {
+	assert.True(t, ValidID("8001015009087"))
+	assert.True(t, ValidID("0002295009084"), "born on 29 February 2000")
+	assert.False(t, ValidID("8001015009088"), "bad check digit")
+	assert.False(t, ValidID("8013015009083"), "bad month")
+	assert.False(t, ValidID("8001015009387"), "bad citizenship digit")
+	assert.False(t, ValidID("800101500908"), "too short")
+}
+
+func TestIDFormatTag(t *testing.T) {
+	type Citizen struct {
+		ID *string `json:"id" format:"za-id"`
+	}
+	valid, invalid := "8001015009087", "8001015009088"
+
+	g := &godantic.Validate{}
+	assert.NoError(t, g.InspectStruct(Citizen{ID: &valid}))
+	assert.Error(t, g.InspectStruct(Citizen{ID: &invalid}))
+	assert.Contains(t, godantic.RegisteredPacks(), "za")
+}
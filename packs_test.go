@@ -0,0 +1,32 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterPack(t *testing.T) {
+	t.Cleanup(func() {
+		packsMux.Lock()
+		delete(packs, "xx")
+		packsMux.Unlock()
+		defaultRegistry.mu.Lock()
+		delete(defaultRegistry.formats, "xx-code")
+		defaultRegistry.mu.Unlock()
+		resetTypeConfigs()
+	})
+	RegisterPack(FormatPack{
+		Country: "XX",
+		Formats: map[string]FormatFunc{
+			"code": func(s string, _ []string) bool { return s == "ok" },
+		},
+	})
+
+	fn, ok := lookupFormat("xx-code")
+	assert.True(t, ok)
+	assert.True(t, fn("ok", nil))
+	assert.False(t, fn("nok", nil))
+	assert.Contains(t, RegisteredPacks(), "xx")
+	assert.Contains(t, RegisteredPacks(), "mz")
+}
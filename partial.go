@@ -0,0 +1,52 @@
+package godantic
+
+import (
+	"reflect"
+	"strings"
+)
+
+// BindJSONPartial behaves like BindJSON but is meant for sparse updates:
+// `binding:"required"` (including conditional `when` bindings) only applies
+// to fields that appear in the payload. A field counts as present when its
+// key is in the JSON, so `0`, `false` and `""` are present while an explicit
+// `null` is not. Objects that do appear in the payload are validated in full,
+// and every other constraint keeps applying to the fields that were sent.
+func (g *Validate) BindJSONPartial(jsonData []byte, obj any) error {
+	return g.publicError(g.bindJSON(jsonData, obj, true))
+}
+
+// payloadMissing checks path against the raw payload of a partial bind.
+// Top-level fields are only missing when sent as null, while fields of an
+// object that was sent must be present like in a full bind. known is false
+// outside of partial binds and for paths the payload can't answer for, such
+// as fields of list elements.
+func (g *Validate) payloadMissing(path string) (missing, known bool) {
+	if g.payload == nil || path == "" {
+		return false, false
+	}
+	keys := strings.Split(path, ".")
+	var node any = g.payload
+	for i, key := range keys {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return false, false
+		}
+		child, exists := m[key]
+		if !exists {
+			return i > 0 && i == len(keys)-1, true
+		}
+		node = child
+	}
+	return node == nil, true
+}
+
+// isMissing reports whether a required field has to be flagged as missing.
+func (g *Validate) isMissing(f reflect.StructField, valField reflect.Value, tree string) bool {
+	if missing, known := g.payloadMissing(fieldName(f, tree)); known {
+		return missing
+	}
+	if f.Type.Kind() == reflect.Ptr {
+		return valField.IsNil()
+	}
+	return reflect.DeepEqual(valField.Interface(), reflect.Zero(f.Type).Interface())
+}
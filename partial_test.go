@@ -0,0 +1,91 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type partialAddress struct {
+	Street *string `json:"street" binding:"required"`
+	City   *string `json:"city" binding:"required"`
+}
+
+type partialProfile struct {
+	Name     *string         `json:"name" binding:"required" min:"3"`
+	Age      int             `json:"age" binding:"required" max:"150"`
+	Active   bool            `json:"active" binding:"required"`
+	Email    *string         `json:"email" binding:"required" format:"email"`
+	Kind     *string         `json:"kind" enum:"individual,organization"`
+	RegNo    *string         `json:"reg_no" when:"kind=organization;binding=required"`
+	Address  *partialAddress `json:"address" binding:"required"`
+	Nickname string          `json:"nickname" binding:"required"`
+}
+
+func TestBindJSONPartial(t *testing.T) {
+	g := &Validate{}
+
+	t.Run("should skip required fields missing from the payload", func(t *testing.T) {
+		var p partialProfile
+		err := g.BindJSONPartial([]byte(`{"name": "John"}`), &p)
+		assert.NoError(t, err)
+		assert.Equal(t, "John", *p.Name)
+	})
+
+	t.Run("should still require fields in a full bind", func(t *testing.T) {
+		var p partialProfile
+		err := g.BindJSON([]byte(`{"name": "John"}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "REQUIRED_FIELD_ERR", err.(*Error).ErrType)
+	})
+
+	t.Run("should treat zero values as present", func(t *testing.T) {
+		var p partialProfile
+		err := g.BindJSONPartial([]byte(`{"age": 0, "active": false, "nickname": ""}`), &p)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject explicit null on a required field", func(t *testing.T) {
+		var p partialProfile
+		err := g.BindJSONPartial([]byte(`{"email": null, "age": 3}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "REQUIRED_FIELD_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "email", err.(*Error).Path)
+	})
+
+	t.Run("should apply other constraints to present fields", func(t *testing.T) {
+		var p partialProfile
+		err := g.BindJSONPartial([]byte(`{"name": "Jo"}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "MIN_LENGTH_ERR", err.(*Error).ErrType)
+
+		p = partialProfile{}
+		err = g.BindJSONPartial([]byte(`{"email": "not-an-email"}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_EMAIL_ERR", err.(*Error).ErrType)
+
+		p = partialProfile{}
+		err = g.BindJSONPartial([]byte(`{"kind": "robot"}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_ENUM_ERR", err.(*Error).ErrType)
+	})
+
+	t.Run("should apply nested required fields inside present objects", func(t *testing.T) {
+		var p partialProfile
+		err := g.BindJSONPartial([]byte(`{"address": {"street": "Av. 24 de Julho"}}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "REQUIRED_FIELD_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "address.city", err.(*Error).Path)
+	})
+
+	t.Run("should only apply conditional bindings to present fields", func(t *testing.T) {
+		var p partialProfile
+		assert.NoError(t, g.BindJSONPartial([]byte(`{"kind": "organization"}`), &p))
+
+		p = partialProfile{}
+		err := g.BindJSONPartial([]byte(`{"kind": "organization", "reg_no": null}`), &p)
+		assert.Error(t, err)
+		assert.Equal(t, "REQUIRED_FIELD_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "reg_no", err.(*Error).Path)
+	})
+}
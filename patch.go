@@ -0,0 +1,704 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PatchOperation is a single RFC 6902 JSON Patch operation.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// BindJSONPatch parses an RFC 6902 JSON Patch document and applies it to obj,
+// which must be a pointer to a struct. Every operation path is checked against
+// the struct schema and every value against the type of the targeted field.
+// The operations are applied to a copy of obj and the result is validated
+// with the full rule set; obj is only updated when all of that succeeds.
+func (g *Validate) BindJSONPatch(patchData []byte, obj any) error {
+	return g.publicError(g.bindJSONPatch(patchData, obj))
+}
+
+func (g *Validate) bindJSONPatch(patchData []byte, obj any) error {
+	target := reflect.ValueOf(obj)
+	if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return &Error{
+			ErrType: "INVALID_PATCH_TARGET_ERR",
+			Path:    "",
+			Message: fmt.Sprintf("The patch target must be a non-nil pointer to a struct, got %T", obj),
+		}
+	}
+
+	if err := g.checkLimits(patchData); err != nil {
+		return err
+	}
+
+	var ops []PatchOperation
+	if err := json.Unmarshal(patchData, &ops); err != nil {
+		return &Error{
+			ErrType: "INVALID_PATCH_ERR",
+			Path:    "",
+			Message: "The given data is not a valid JSON Patch document",
+		}
+	}
+
+	docBytes, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var doc any
+	if err := unmarshalJSON(docBytes, &doc); err != nil {
+		return err
+	}
+	if m, ok := doc.(map[string]any); ok {
+		patchDocument(m, target.Elem().Type())
+	}
+
+	refData := buildRefData(obj)
+	for i, op := range ops {
+		doc, err = g.applyPatchOperation(doc, refData, target.Elem().Type(), op, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	patched, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	result := reflect.New(target.Elem().Type())
+	result.Elem().Set(patchBase(target.Elem()))
+	if err := g.bindJSON(patched, result.Interface(), false); err != nil {
+		return err
+	}
+	keepIgnored(result.Elem(), target.Elem())
+	target.Elem().Set(result.Elem())
+	return nil
+}
+
+// patchDocument turns data, obj encoded to JSON, into what a client would
+// send for a struct of type t: fields tagged `binding:"ignore"` are left
+// out, keepIgnored carries them over once the document is bound, and times
+// are written in the Unix layouts of their `time_format` tag, which don't
+// accept RFC 3339.
+func patchDocument(data map[string]any, t reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.ConvertibleTo(TimeType) || isValueType(t) {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := structField(t, i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		switch {
+		case name == "-":
+			continue
+		case name == "" && f.Anonymous:
+			patchDocument(data, f.Type)
+			continue
+		case name == "":
+			name = f.Name
+		}
+		if f.Tag.Get("binding") == "ignore" {
+			delete(data, name)
+			continue
+		}
+		if raw, ok := data[name]; ok {
+			data[name] = patchDocumentValue(f, f.Type, raw)
+		}
+	}
+}
+
+func patchDocumentValue(f reflect.StructField, t reflect.Type, raw any) any {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case isValueType(t):
+	case t.ConvertibleTo(TimeType):
+		layout := f.Tag.Get("time_format")
+		s, ok := raw.(string)
+		if !ok || (layout != TimeFormatUnix && layout != TimeFormatUnixMilli) {
+			return raw
+		}
+		value, err := time.Parse(time.RFC3339Nano, s)
+		switch {
+		case err != nil:
+			return raw
+		case value.IsZero():
+			// The zero time is what an absent field decodes to.
+			return nil
+		case layout == TimeFormatUnix:
+			return json.Number(strconv.FormatInt(value.Unix(), 10))
+		default:
+			return json.Number(strconv.FormatInt(value.UnixMilli(), 10))
+		}
+	case t.Kind() == reflect.Struct:
+		if m, ok := raw.(map[string]any); ok {
+			patchDocument(m, t)
+		}
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		if list, ok := raw.([]any); ok {
+			for i := range list {
+				list[i] = patchDocumentValue(f, t.Elem(), list[i])
+			}
+		}
+	}
+	return raw
+}
+
+// keepIgnored copies the fields tagged `binding:"ignore"` of src, the value
+// before the patch, to dst, the patched one, in dst and its nested structs.
+func keepIgnored(dst, src reflect.Value) {
+	for dst.Kind() == reflect.Ptr {
+		if dst.IsNil() || src.IsNil() {
+			return
+		}
+		dst, src = dst.Elem(), src.Elem()
+	}
+	t := dst.Type()
+	if t.Kind() != reflect.Struct || t.ConvertibleTo(TimeType) || isValueType(t) {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := structField(t, i)
+		switch {
+		case f.PkgPath != "" || f.Tag.Get("json") == "-":
+		case f.Tag.Get("binding") == "ignore":
+			dst.Field(i).Set(src.Field(i))
+		default:
+			keepIgnored(dst.Field(i), src.Field(i))
+		}
+	}
+}
+
+// ignoredPatchPath returns the dotted path of the field tagged
+// `binding:"ignore"` that tokens go through in t, if any. Such fields are
+// set by the server and can't be patched.
+func ignoredPatchPath(t reflect.Type, tokens []string) (string, bool) {
+	path := ""
+	for _, token := range tokens {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			f, ok := patchField(t, token)
+			if !ok {
+				return "", false
+			}
+			path = joinPatchPath(path, token, false)
+			if f.Tag.Get("binding") == "ignore" {
+				return path, true
+			}
+			t = f.Type
+		case reflect.Slice, reflect.Array:
+			path = joinPatchPath(path, token, true)
+			t = t.Elem()
+		case reflect.Map:
+			path = joinPatchPath(path, token, false)
+			t = t.Elem()
+		default:
+			return "", false
+		}
+	}
+	return "", false
+}
+
+// patchField returns the field of t encoded as name, looking into embedded
+// structs.
+func patchField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := structField(t, i)
+		if f.PkgPath != "" {
+			continue
+		}
+		jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+		if jsonName == "" && f.Anonymous {
+			embedded := f.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if found, ok := patchField(embedded, name); ok {
+					return found, true
+				}
+				continue
+			}
+		}
+		if jsonName == "" {
+			jsonName = f.Name
+		}
+		if jsonName == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// patchBase returns a copy of v with the fields JSON encodes zeroed, so the
+// patched document is decoded onto the fields it doesn't carry: unexported
+// ones and those tagged `json:"-"`.
+func patchBase(v reflect.Value) reflect.Value {
+	base := reflect.New(v.Type()).Elem()
+	base.Set(v)
+	if v.Kind() != reflect.Struct {
+		return base
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		switch {
+		case f.PkgPath != "" || f.Tag.Get("json") == "-":
+		case f.Anonymous && f.Type.Kind() == reflect.Struct:
+			base.Field(i).Set(patchBase(v.Field(i)))
+		default:
+			base.Field(i).Set(reflect.Zero(f.Type))
+		}
+	}
+	return base
+}
+
+func (g *Validate) applyPatchOperation(doc any, refData map[string]any, t reflect.Type, op PatchOperation, index int) (any, error) {
+	tokens, err := parsePointer(op.Path, index)
+	if err != nil {
+		return nil, err
+	}
+	ref, path, err := resolvePatchRef(refData, tokens)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIgnoredPatchPath(t, tokens, index); err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add", "replace", "test":
+		value, err := patchValue(op, ref, path, index)
+		if err != nil {
+			return nil, err
+		}
+		switch op.Op {
+		case "add":
+			return patchAdd(doc, tokens, value, path)
+		case "replace":
+			return patchReplace(doc, tokens, value, path)
+		default:
+			current, err := patchGet(doc, tokens, path)
+			if err != nil {
+				return nil, err
+			}
+			if !patchValuesEqual(current, value) {
+				return nil, &Error{
+					ErrType: "PATCH_TEST_FAILED_ERR",
+					Path:    path,
+					Message: fmt.Sprintf("Patch operation %d: the field <%s> does not have the expected value", index, path),
+				}
+			}
+			return doc, nil
+		}
+	case "remove":
+		return patchRemove(doc, tokens, path)
+	case "move", "copy":
+		fromTokens, err := parsePointer(op.From, index)
+		if err != nil {
+			return nil, err
+		}
+		_, fromPath, err := resolvePatchRef(refData, fromTokens)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkIgnoredPatchPath(t, fromTokens, index); err != nil {
+			return nil, err
+		}
+		value, err := patchGet(doc, fromTokens, fromPath)
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == "move" {
+			if strings.HasPrefix(op.Path+"/", op.From+"/") && op.Path != op.From {
+				return nil, &Error{
+					ErrType: "INVALID_PATCH_ERR",
+					Path:    path,
+					Message: fmt.Sprintf("Patch operation %d: cannot move <%s> into one of its children", index, fromPath),
+				}
+			}
+			if doc, err = patchRemove(doc, fromTokens, fromPath); err != nil {
+				return nil, err
+			}
+		} else {
+			value = deepCopyJSON(value)
+		}
+		return patchAdd(doc, tokens, value, path)
+	default:
+		return nil, &Error{
+			ErrType: "INVALID_PATCH_ERR",
+			Path:    "",
+			Message: fmt.Sprintf("Patch operation %d: unknown op '%s'", index, op.Op),
+		}
+	}
+}
+
+func checkIgnoredPatchPath(t reflect.Type, tokens []string, index int) error {
+	path, ok := ignoredPatchPath(t, tokens)
+	if !ok {
+		return nil
+	}
+	return &Error{
+		ErrType: "PATCH_PATH_ERR",
+		Path:    path,
+		Message: fmt.Sprintf("Patch operation %d: the field <%s> cannot be patched", index, path),
+	}
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its unescaped tokens.
+func parsePointer(pointer string, index int) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, &Error{
+			ErrType: "INVALID_PATCH_ERR",
+			Path:    "",
+			Message: fmt.Sprintf("Patch operation %d: invalid JSON pointer '%s'", index, pointer),
+		}
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// resolvePatchRef checks that tokens address a field of the schema described
+// by refData and returns the reference value found there with its dotted path.
+func resolvePatchRef(refData map[string]any, tokens []string) (any, string, error) {
+	var ref any = refData
+	path := ""
+	for _, token := range tokens {
+		switch r := ref.(type) {
+		case Object:
+			return nil, joinPatchPath(path, token, false), nil
+		case map[string]any:
+			path = joinPatchPath(path, token, false)
+			if len(r) == 0 {
+				// Plain maps carry no schema, any key is accepted.
+				return nil, path, nil
+			}
+			child, ok := r[token]
+			if !ok {
+				return nil, path, &Error{
+					ErrType: "PATCH_PATH_ERR",
+					Path:    path,
+					Message: fmt.Sprintf("The field <%s> does not exist", path),
+				}
+			}
+			ref = child
+		case []any:
+			if _, err := strconv.Atoi(token); err != nil && token != "-" {
+				return nil, path, &Error{
+					ErrType: "PATCH_PATH_ERR",
+					Path:    path,
+					Message: fmt.Sprintf("The list <%s> cannot be addressed with '%s'", path, token),
+				}
+			}
+			path = joinPatchPath(path, token, true)
+			if len(r) == 0 {
+				return nil, path, nil
+			}
+			ref = r[0]
+		default:
+			t := reflect.TypeOf(r)
+			for t != nil && t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			if t == nil || t.Kind() != reflect.Struct || t.ConvertibleTo(TimeType) || isValueType(t) {
+				next := joinPatchPath(path, token, false)
+				return nil, next, &Error{
+					ErrType: "PATCH_PATH_ERR",
+					Path:    next,
+					Message: fmt.Sprintf("The field <%s> does not exist", next),
+				}
+			}
+			// Nil pointers to structs are not expanded by buildRefData.
+			nested := buildRefData(reflect.New(t).Interface())
+			child, ok := nested[token]
+			path = joinPatchPath(path, token, false)
+			if !ok {
+				return nil, path, &Error{
+					ErrType: "PATCH_PATH_ERR",
+					Path:    path,
+					Message: fmt.Sprintf("The field <%s> does not exist", path),
+				}
+			}
+			ref = child
+		}
+	}
+	return ref, path, nil
+}
+
+func joinPatchPath(path, token string, index bool) string {
+	if index {
+		return fmt.Sprintf("%s[%s]", path, token)
+	}
+	if path == "" {
+		return token
+	}
+	return path + "." + token
+}
+
+// patchValue decodes the operation value and checks it against the type of
+// the field it targets.
+func patchValue(op PatchOperation, ref any, path string, index int) (any, error) {
+	if len(op.Value) == 0 {
+		return nil, &Error{
+			ErrType: "INVALID_PATCH_ERR",
+			Path:    path,
+			Message: fmt.Sprintf("Patch operation %d: '%s' requires a value", index, op.Op),
+		}
+	}
+	var value any
+	if err := unmarshalJSON(op.Value, &value); err != nil {
+		return nil, &Error{
+			ErrType: "INVALID_PATCH_ERR",
+			Path:    path,
+			Message: fmt.Sprintf("Patch operation %d: the value is not valid JSON", index),
+		}
+	}
+
+	var expected string
+	switch ref.(type) {
+	case nil:
+		return value, nil
+	case Object, map[string]any:
+		if _, ok := value.(map[string]any); !ok {
+			expected = "object"
+		}
+	case []any:
+		if _, ok := value.([]any); !ok {
+			expected = "list"
+		}
+	default:
+		t := reflect.TypeOf(ref)
+		if isTimeType(t) {
+			// The layout depends on the field's time_format tag; BindJSON
+			// parses the value and reports its path.
+			switch value.(type) {
+			case string, json.Number:
+			default:
+				expected = "time"
+			}
+		} else if err := json.Unmarshal(op.Value, reflect.New(t).Interface()); err != nil {
+			expected = t.String()
+		}
+	}
+	if expected != "" {
+		return nil, &Error{
+			ErrType: "TYPE_MISMATCH_ERR",
+			Path:    path,
+			Message: fmt.Sprintf("The field <%s> was given an invalid type, the expected type is `%s`", path, expected),
+		}
+	}
+	return value, nil
+}
+
+func patchGet(doc any, tokens []string, path string) (any, error) {
+	node := doc
+	for _, token := range tokens {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[token]
+			if !ok {
+				return nil, patchMissingError(path)
+			}
+			node = child
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, patchMissingError(path)
+			}
+			node = n[i]
+		default:
+			return nil, patchMissingError(path)
+		}
+	}
+	return node, nil
+}
+
+// patchParent walks doc down to the container holding the last token and
+// hands it to fn. Containers returned by fn are written back on the way up,
+// so lists can grow or shrink.
+func patchParent(node any, tokens []string, path string, fn func(parent any, key string) (any, error)) (any, error) {
+	if len(tokens) == 1 {
+		return fn(node, tokens[0])
+	}
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[tokens[0]]
+		if !ok {
+			return nil, patchMissingError(path)
+		}
+		updated, err := patchParent(child, tokens[1:], path, fn)
+		if err != nil {
+			return nil, err
+		}
+		n[tokens[0]] = updated
+		return n, nil
+	case []any:
+		i, err := strconv.Atoi(tokens[0])
+		if err != nil || i < 0 || i >= len(n) {
+			return nil, patchMissingError(path)
+		}
+		updated, err := patchParent(n[i], tokens[1:], path, fn)
+		if err != nil {
+			return nil, err
+		}
+		n[i] = updated
+		return n, nil
+	default:
+		return nil, patchMissingError(path)
+	}
+}
+
+func patchAdd(doc any, tokens []string, value any, path string) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return patchParent(doc, tokens, path, func(parent any, key string) (any, error) {
+		switch p := parent.(type) {
+		case map[string]any:
+			p[key] = value
+			return p, nil
+		case []any:
+			i := len(p)
+			if key != "-" {
+				var err error
+				if i, err = strconv.Atoi(key); err != nil || i < 0 || i > len(p) {
+					return nil, patchMissingError(path)
+				}
+			}
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+			p[i] = value
+			return p, nil
+		default:
+			return nil, patchMissingError(path)
+		}
+	})
+}
+
+func patchReplace(doc any, tokens []string, value any, path string) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return patchParent(doc, tokens, path, func(parent any, key string) (any, error) {
+		switch p := parent.(type) {
+		case map[string]any:
+			if _, ok := p[key]; !ok {
+				return nil, patchMissingError(path)
+			}
+			p[key] = value
+			return p, nil
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(p) {
+				return nil, patchMissingError(path)
+			}
+			p[i] = value
+			return p, nil
+		default:
+			return nil, patchMissingError(path)
+		}
+	})
+}
+
+func patchRemove(doc any, tokens []string, path string) (any, error) {
+	if len(tokens) == 0 {
+		return nil, &Error{
+			ErrType: "INVALID_PATCH_ERR",
+			Path:    "",
+			Message: "The document root cannot be removed",
+		}
+	}
+	return patchParent(doc, tokens, path, func(parent any, key string) (any, error) {
+		switch p := parent.(type) {
+		case map[string]any:
+			if _, ok := p[key]; !ok {
+				return nil, patchMissingError(path)
+			}
+			delete(p, key)
+			return p, nil
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(p) {
+				return nil, patchMissingError(path)
+			}
+			return append(p[:i], p[i+1:]...), nil
+		default:
+			return nil, patchMissingError(path)
+		}
+	})
+}
+
+func patchMissingError(path string) error {
+	return &Error{
+		ErrType: "PATCH_PATH_ERR",
+		Path:    path,
+		Message: fmt.Sprintf("The field <%s> is not present in the document", path),
+	}
+}
+
+func deepCopyJSON(value any) any {
+	data, _ := json.Marshal(value)
+	var out any
+	_ = unmarshalJSON(data, &out)
+	return out
+}
+
+// patchValuesEqual compares decoded JSON values, treating numbers as equal when
+// their values are, as RFC 6902 requires for "test" (1.0 equals 1).
+func patchValuesEqual(a, b any) bool {
+	switch a := a.(type) {
+	case json.Number:
+		n, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, errX := ParseDecimal(a.String())
+		y, errY := ParseDecimal(n.String())
+		return errX == nil && errY == nil && x.Cmp(y) == 0
+	case map[string]any:
+		m, ok := b.(map[string]any)
+		if !ok || len(a) != len(m) {
+			return false
+		}
+		for k, v := range a {
+			if w, ok := m[k]; !ok || !patchValuesEqual(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		l, ok := b.([]any)
+		if !ok || len(a) != len(l) {
+			return false
+		}
+		for i := range a {
+			if !patchValuesEqual(a[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,165 @@
+package godantic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type patchItem struct {
+	SKU      string `json:"sku" binding:"required"`
+	Quantity int    `json:"quantity" min:"1"`
+}
+
+type patchOrder struct {
+	Name   string      `json:"name" binding:"required" min:"3"`
+	Status *string     `json:"status" enum:"open,closed"`
+	Tags   []string    `json:"tags"`
+	Items  []patchItem `json:"items"`
+}
+
+func newPatchOrder() patchOrder {
+	return patchOrder{
+		Name:  "order",
+		Tags:  []string{"a"},
+		Items: []patchItem{{SKU: "A1", Quantity: 1}},
+	}
+}
+
+func TestBindJSONPatch(t *testing.T) {
+	g := &Validate{}
+
+	t.Run("should apply add, replace, remove, copy and move", func(t *testing.T) {
+		order := newPatchOrder()
+		patch := `[
+			{"op": "replace", "path": "/name", "value": "renamed"},
+			{"op": "add", "path": "/status", "value": "open"},
+			{"op": "add", "path": "/tags/-", "value": "b"},
+			{"op": "add", "path": "/items/0", "value": {"sku": "A0", "quantity": 2}},
+			{"op": "copy", "from": "/tags/0", "path": "/tags/-"},
+			{"op": "remove", "path": "/tags/0"},
+			{"op": "move", "from": "/tags/1", "path": "/tags/0"},
+			{"op": "test", "path": "/items/1/sku", "value": "A1"}
+		]`
+		err := g.BindJSONPatch([]byte(patch), &order)
+		assert.NoError(t, err)
+		assert.Equal(t, "renamed", order.Name)
+		assert.Equal(t, "open", *order.Status)
+		assert.Equal(t, []string{"a", "b"}, order.Tags)
+		assert.Equal(t, []patchItem{{SKU: "A0", Quantity: 2}, {SKU: "A1", Quantity: 1}}, order.Items)
+	})
+
+	t.Run("should reject paths that are not in the schema", func(t *testing.T) {
+		order := newPatchOrder()
+		err := g.BindJSONPatch([]byte(`[{"op": "add", "path": "/items/0/price", "value": 10}]`), &order)
+		assert.Error(t, err)
+		assert.Equal(t, "PATCH_PATH_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "items[0].price", err.(*Error).Path)
+	})
+
+	t.Run("should reject values of the wrong type", func(t *testing.T) {
+		order := newPatchOrder()
+		err := g.BindJSONPatch([]byte(`[{"op": "replace", "path": "/items/0/quantity", "value": "two"}]`), &order)
+		assert.Error(t, err)
+		assert.Equal(t, "TYPE_MISMATCH_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "items[0].quantity", err.(*Error).Path)
+	})
+
+	t.Run("should validate the patched document with the full rule set", func(t *testing.T) {
+		order := newPatchOrder()
+		err := g.BindJSONPatch([]byte(`[
+			{"op": "replace", "path": "/name", "value": "new name"},
+			{"op": "replace", "path": "/status", "value": "pending"}
+		]`), &order)
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_ENUM_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "order", order.Name, "original must be left unchanged")
+		assert.Nil(t, order.Status)
+	})
+
+	t.Run("should leave the original unchanged when an op fails", func(t *testing.T) {
+		order := newPatchOrder()
+		err := g.BindJSONPatch([]byte(`[
+			{"op": "replace", "path": "/name", "value": "new name"},
+			{"op": "remove", "path": "/tags/5"}
+		]`), &order)
+		assert.Error(t, err)
+		assert.Equal(t, "PATCH_PATH_ERR", err.(*Error).ErrType)
+		assert.Equal(t, newPatchOrder(), order)
+	})
+
+	t.Run("should keep the fields JSON doesn't carry", func(t *testing.T) {
+		type record struct {
+			ID    int    `json:"-"`
+			Name  string `json:"name"`
+			Note  string `json:"note"`
+			owner string
+		}
+		rec := record{ID: 42, Name: "old", Note: "draft", owner: "ops"}
+		err := g.BindJSONPatch([]byte(`[
+			{"op": "replace", "path": "/name", "value": "new"},
+			{"op": "remove", "path": "/note"}
+		]`), &rec)
+		assert.NoError(t, err)
+		assert.Equal(t, record{ID: 42, Name: "new", owner: "ops"}, rec)
+	})
+
+	t.Run("should keep fields tagged binding:ignore and reject ops on them", func(t *testing.T) {
+		type record struct {
+			ID   string `json:"id" binding:"ignore"`
+			Name string `json:"name"`
+		}
+		rec := record{ID: "srv-1", Name: "old"}
+		err := g.BindJSONPatch([]byte(`[{"op": "replace", "path": "/name", "value": "new"}]`), &rec)
+		assert.NoError(t, err)
+		assert.Equal(t, record{ID: "srv-1", Name: "new"}, rec)
+
+		err = g.BindJSONPatch([]byte(`[{"op": "replace", "path": "/id", "value": "mine"}]`), &rec)
+		if assert.Error(t, err) {
+			assert.Equal(t, "PATCH_PATH_ERR", err.(*Error).ErrType)
+			assert.Equal(t, "id", err.(*Error).Path)
+		}
+		assert.Equal(t, record{ID: "srv-1", Name: "new"}, rec)
+	})
+
+	t.Run("should keep times in their time_format layout", func(t *testing.T) {
+		type record struct {
+			Name    string     `json:"name"`
+			Created time.Time  `json:"created" time_format:"unix"`
+			Updated *time.Time `json:"updated" time_format:"unix_ms"`
+		}
+		created := time.Unix(1700000000, 0).UTC()
+		updated := time.UnixMilli(1700000000123).UTC()
+		rec := record{Name: "old", Created: created, Updated: &updated}
+		err := g.BindJSONPatch([]byte(`[{"op": "replace", "path": "/name", "value": "new"}]`), &rec)
+		assert.NoError(t, err)
+		assert.Equal(t, "new", rec.Name)
+		assert.True(t, created.Equal(rec.Created), rec.Created)
+		if assert.NotNil(t, rec.Updated) {
+			assert.True(t, updated.Equal(*rec.Updated), *rec.Updated)
+		}
+
+		err = g.BindJSONPatch([]byte(`[{"op": "test", "path": "/created", "value": 1700000000}]`), &rec)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail a test op with a different value", func(t *testing.T) {
+		order := newPatchOrder()
+		err := g.BindJSONPatch([]byte(`[{"op": "test", "path": "/name", "value": "other"}]`), &order)
+		assert.Error(t, err)
+		assert.Equal(t, "PATCH_TEST_FAILED_ERR", err.(*Error).ErrType)
+	})
+
+	t.Run("should reject unknown ops and invalid documents", func(t *testing.T) {
+		order := newPatchOrder()
+		err := g.BindJSONPatch([]byte(`[{"op": "merge", "path": "/name", "value": "x"}]`), &order)
+		assert.Equal(t, "INVALID_PATCH_ERR", err.(*Error).ErrType)
+
+		err = g.BindJSONPatch([]byte(`{"op": "add"}`), &order)
+		assert.Equal(t, "INVALID_PATCH_ERR", err.(*Error).ErrType)
+
+		err = g.BindJSONPatch([]byte(`[]`), order)
+		assert.Equal(t, "INVALID_PATCH_TARGET_ERR", err.(*Error).ErrType)
+	})
+}
@@ -0,0 +1,250 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Phone number types reported by ParsePhone.
+const (
+	PhoneTypeMobile        = "mobile"
+	PhoneTypeFixedLine     = "fixed_line"
+	PhoneTypeFixedOrMobile = "fixed_line_or_mobile"
+)
+
+// PhonePattern describes the national significant numbers of one type.
+type PhonePattern struct {
+	Type string
+	// Pattern is a regular expression matched against the whole national
+	// significant number.
+	Pattern string
+}
+
+// PhoneRegion is the phone metadata of one region.
+type PhoneRegion struct {
+	// Region is the ISO 3166-1 alpha-2 code, e.g. "MZ".
+	Region string
+	// CallingCode is the country calling code without "+", e.g. "258".
+	CallingCode string
+	// NationalPrefix is the trunk prefix dialled before national numbers,
+	// e.g. "0". Leave it empty for regions without one.
+	NationalPrefix string
+	// Patterns are tried in order; the first match gives the number type.
+	Patterns []PhonePattern
+}
+
+// PhoneNumber is a phone number split into its parts.
+type PhoneNumber struct {
+	Region         string
+	CountryCode    string
+	NationalNumber string
+	Type           string
+}
+
+// E164 returns the number in canonical E.164 form, e.g. "+258841234567".
+func (p PhoneNumber) E164() string {
+	return "+" + p.CountryCode + p.NationalNumber
+}
+
+type phoneRegion struct {
+	PhoneRegion
+	patterns []*regexp.Regexp
+}
+
+// match returns the type of the national significant number nsn, or "".
+func (r *phoneRegion) match(nsn string) string {
+	for i, re := range r.patterns {
+		if re.MatchString(nsn) {
+			return r.Patterns[i].Type
+		}
+	}
+	return ""
+}
+
+var (
+	phoneRegionsByName = make(map[string]*phoneRegion)
+	phoneRegionsByCode = make(map[string][]*phoneRegion)
+	phoneMux           sync.RWMutex
+
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+)
+
+// RegisterPhoneRegion adds or replaces the phone metadata of a region.
+// It panics if a pattern is not a valid regular expression.
+func RegisterPhoneRegion(region PhoneRegion) {
+	r := &phoneRegion{PhoneRegion: region}
+	r.Region = strings.ToUpper(r.Region)
+	for _, p := range region.Patterns {
+		r.patterns = append(r.patterns, regexp.MustCompile(`^(?:`+p.Pattern+`)$`))
+	}
+
+	phoneMux.Lock()
+	defer phoneMux.Unlock()
+
+	if old, ok := phoneRegionsByName[r.Region]; ok {
+		regions := phoneRegionsByCode[old.CallingCode]
+		for i := range regions {
+			if regions[i] == old {
+				phoneRegionsByCode[old.CallingCode] = append(regions[:i:i], regions[i+1:]...)
+				break
+			}
+		}
+	}
+	phoneRegionsByName[r.Region] = r
+	phoneRegionsByCode[r.CallingCode] = append(phoneRegionsByCode[r.CallingCode], r)
+	resetTypeConfigs()
+}
+
+func lookupPhoneRegion(region string) (*phoneRegion, bool) {
+	phoneMux.RLock()
+	defer phoneMux.RUnlock()
+
+	r, ok := phoneRegionsByName[strings.ToUpper(region)]
+	return r, ok
+}
+
+// ParsePhone parses a phone number. Numbers starting with "+" or "00" are
+// read as international numbers; any other number is read as a national
+// number of defaultRegion, with or without its national prefix. Spaces,
+// hyphens, dots and parentheses are ignored.
+func ParsePhone(number, defaultRegion string) (PhoneNumber, error) {
+	digits := phoneSeparators.Replace(strings.TrimSpace(number))
+	international := false
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits, international = digits[1:], true
+	case strings.HasPrefix(digits, "00"):
+		digits, international = digits[2:], true
+	}
+	if !isDigits(digits) {
+		return PhoneNumber{}, fmt.Errorf("'%s' is not a phone number", number)
+	}
+
+	phoneMux.RLock()
+	defer phoneMux.RUnlock()
+
+	if international {
+		for n := 1; n <= 3 && n < len(digits); n++ {
+			for _, r := range phoneRegionsByCode[digits[:n]] {
+				if typ := r.match(digits[n:]); typ != "" {
+					return PhoneNumber{Region: r.Region, CountryCode: r.CallingCode, NationalNumber: digits[n:], Type: typ}, nil
+				}
+			}
+		}
+		return PhoneNumber{}, fmt.Errorf("'%s' is not a valid number for any known region", number)
+	}
+
+	if defaultRegion == "" {
+		return PhoneNumber{}, fmt.Errorf("'%s' must be in international format", number)
+	}
+	r, ok := phoneRegionsByName[strings.ToUpper(defaultRegion)]
+	if !ok {
+		return PhoneNumber{}, fmt.Errorf("unknown phone region '%s'", defaultRegion)
+	}
+	candidates := []string{digits}
+	if r.NationalPrefix != "" && strings.HasPrefix(digits, r.NationalPrefix) {
+		candidates = append(candidates, digits[len(r.NationalPrefix):])
+	}
+	if strings.HasPrefix(digits, r.CallingCode) {
+		candidates = append(candidates, digits[len(r.CallingCode):])
+	}
+	for _, nsn := range candidates {
+		if typ := r.match(nsn); typ != "" {
+			return PhoneNumber{Region: r.Region, CountryCode: r.CallingCode, NationalNumber: nsn, Type: typ}, nil
+		}
+	}
+	return PhoneNumber{}, fmt.Errorf("'%s' is not a valid number for region %s", number, r.Region)
+}
+
+// NormalizePhone returns a phone number in canonical E.164 form.
+func NormalizePhone(number, defaultRegion string) (string, error) {
+	p, err := ParsePhone(number, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+	return p.E164(), nil
+}
+
+// phoneTypeMatches reports whether a number of type actual satisfies the
+// wanted type. Numbers that can't be told apart match both.
+func phoneTypeMatches(actual, wanted string) bool {
+	return wanted == "" || actual == wanted || actual == PhoneTypeFixedOrMobile
+}
+
+// phoneParams reads the region and type options of the phone format, given
+// either as `phone_region`/`phone_type` tags or as format parameters, e.g.
+// `format:"phone(MZ,mobile)"`.
+func phoneParams(params []string) (region, typ string) {
+	for _, param := range params {
+		key, value := "", param
+		if i := strings.Index(param, "="); i != -1 {
+			key, value = param[:i], param[i+1:]
+		}
+		switch {
+		case key == "phone_region", key == "" && len(value) == 2:
+			region = strings.ToUpper(value)
+		case key == "phone_type", key == "":
+			typ = value
+		}
+	}
+	return region, typ
+}
+
+// e164Pattern is the shape of an E.164 number, used for numbers of calling
+// codes without metadata.
+var e164Pattern = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// isPhone validates a phone number. With a region only numbers of that
+// region are accepted, and national forms are read as numbers of it.
+// Without options, numbers of calling codes without metadata only need the
+// E.164 shape.
+func isPhone(s string, params []string) bool {
+	region, typ := phoneParams(params)
+	p, err := ParsePhone(s, region)
+	if err != nil {
+		return region == "" && typ == "" && e164Pattern.MatchString(s) && !knownCallingCode(s[1:])
+	}
+	if region != "" && p.Region != region {
+		return false
+	}
+	return phoneTypeMatches(p.Type, typ)
+}
+
+// knownCallingCode reports whether the international number digits starts
+// with the calling code of a registered region.
+func knownCallingCode(digits string) bool {
+	phoneMux.RLock()
+	defer phoneMux.RUnlock()
+
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		if len(phoneRegionsByCode[digits[:n]]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func checkPhoneConfig(f reflect.StructField) string {
+	if region := f.Tag.Get("phone_region"); region != "" {
+		if _, ok := lookupPhoneRegion(region); !ok {
+			return fmt.Sprintf("unknown phone region '%s'", region)
+		}
+	}
+	switch typ := f.Tag.Get("phone_type"); typ {
+	case "", PhoneTypeMobile, PhoneTypeFixedLine, PhoneTypeFixedOrMobile:
+	default:
+		return fmt.Sprintf("unknown phone type '%s'", typ)
+	}
+	return ""
+}
+
+func init() {
+	for _, region := range phoneRegions {
+		RegisterPhoneRegion(region)
+	}
+	RegisterFormatWithParams("phone", isPhone)
+	formatTagOptions["phone"] = []string{"phone_region", "phone_type"}
+}
@@ -0,0 +1,159 @@
+package godantic
+
+// canadianAreaCodes lists the NANP area codes assigned to Canada; other
+// +1 numbers are attributed to the US.
+const canadianAreaCodes = `204|226|236|249|250|263|289|306|343|354|365|367|368|382|403|416|418|428|431|437|438|450|468|474|506|514|519|548|579|581|584|587|604|613|639|647|672|683|705|709|742|753|778|780|782|807|819|825|867|873|879|902|905`
+
+// phoneRegions is the embedded phone metadata. Patterns match the national
+// significant number, i.e. the digits after the country calling code
+// without any national (trunk) prefix. More regions can be added with
+// RegisterPhoneRegion.
+var phoneRegions = []PhoneRegion{
+	// Southern and East Africa
+	{Region: "MZ", CallingCode: "258", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `8[2-7]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2[1-9]\d{6}`},
+	}},
+	{Region: "ZA", CallingCode: "27", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `(6\d|7[1-9]|8[1-4])\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-5]\d{8}`},
+	}},
+	{Region: "ZW", CallingCode: "263", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `7[1378]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2-6]\d{4,8}`},
+	}},
+	{Region: "ZM", CallingCode: "260", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `(7[5-7]|9[5-7])\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `21\d{7}`},
+	}},
+	{Region: "MW", CallingCode: "265", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `(8[89]|9[89])\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `1\d{6}`},
+	}},
+	{Region: "TZ", CallingCode: "255", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[67]\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2[2-8]\d{7}`},
+	}},
+	{Region: "KE", CallingCode: "254", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `(7\d|1[01])\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2-6]\d{7,8}`},
+	}},
+	{Region: "UG", CallingCode: "256", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `7\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[34]\d{8}`},
+	}},
+	{Region: "RW", CallingCode: "250", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `7[2389]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2\d{8}`},
+	}},
+	{Region: "ET", CallingCode: "251", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[79]\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-5]\d{8}`},
+	}},
+	{Region: "AO", CallingCode: "244", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `9[1-9]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2\d{8}`},
+	}},
+	{Region: "NA", CallingCode: "264", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `8[1-5]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `6\d{7,8}`},
+	}},
+	{Region: "BW", CallingCode: "267", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `7[1-8]\d{6}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2-6]\d{6}`},
+	}},
+	{Region: "SZ", CallingCode: "268", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `7[6-9]\d{6}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2\d{7}`},
+	}},
+	{Region: "LS", CallingCode: "266", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[56]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2\d{7}`},
+	}},
+	{Region: "CV", CallingCode: "238", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `9\d{6}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2\d{6}`},
+	}},
+
+	// West and North Africa
+	{Region: "NG", CallingCode: "234", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `(70|8[01]|9[01])\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-9]\d{6,7}`},
+	}},
+	{Region: "GH", CallingCode: "233", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `(2[03-8]|5[0-79])\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `3\d{8}`},
+	}},
+	{Region: "EG", CallingCode: "20", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `1[0125]\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2-9]\d{7,8}`},
+	}},
+
+	// Europe
+	{Region: "PT", CallingCode: "351", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `9[1236]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `2\d{8}`},
+	}},
+	{Region: "ES", CallingCode: "34", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[67]\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[89]\d{8}`},
+	}},
+	{Region: "FR", CallingCode: "33", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[67]\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-59]\d{8}`},
+	}},
+	{Region: "GB", CallingCode: "44", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `7[1-57-9]\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-3]\d{8,9}`},
+	}},
+	{Region: "DE", CallingCode: "49", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `1[5-7]\d{8,9}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2-9]\d{5,10}`},
+	}},
+	{Region: "IT", CallingCode: "39", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `3\d{8,9}`},
+		{Type: PhoneTypeFixedLine, Pattern: `0\d{5,10}`},
+	}},
+	{Region: "NL", CallingCode: "31", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `6[1-9]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-578]\d{8}`},
+	}},
+	{Region: "RU", CallingCode: "7", NationalPrefix: "8", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `9\d{9}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[348]\d{9}`},
+	}},
+
+	// Americas
+	{Region: "CA", CallingCode: "1", NationalPrefix: "1", Patterns: []PhonePattern{
+		{Type: PhoneTypeFixedOrMobile, Pattern: `(` + canadianAreaCodes + `)[2-9]\d{6}`},
+	}},
+	{Region: "US", CallingCode: "1", NationalPrefix: "1", Patterns: []PhonePattern{
+		{Type: PhoneTypeFixedOrMobile, Pattern: `[2-9]\d{2}[2-9]\d{6}`},
+	}},
+	{Region: "BR", CallingCode: "55", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[1-9][1-9]9\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-9][1-9][2-5]\d{7}`},
+	}},
+
+	// Asia and Oceania
+	{Region: "IN", CallingCode: "91", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[6-9]\d{9}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-5]\d{9}`},
+	}},
+	{Region: "CN", CallingCode: "86", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `1[3-9]\d{9}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2-9]\d{8,10}`},
+	}},
+	{Region: "JP", CallingCode: "81", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `[789]0\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[1-9]\d{8}`},
+	}},
+	{Region: "AE", CallingCode: "971", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `5[024568]\d{7}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2-79]\d{7}`},
+	}},
+	{Region: "AU", CallingCode: "61", NationalPrefix: "0", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `4\d{8}`},
+		{Type: PhoneTypeFixedLine, Pattern: `[2378]\d{8}`},
+	}},
+}
@@ -0,0 +1,154 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePhone(t *testing.T) {
+	testCases := []struct {
+		input   string
+		region  string
+		e164    string
+		country string
+		typ     string
+	}{
+		{"+258 84 123 4567", "", "+258841234567", "MZ", PhoneTypeMobile},
+		{"0025821123456", "", "+25821123456", "MZ", PhoneTypeFixedLine},
+		{"84 123 4567", "MZ", "+258841234567", "MZ", PhoneTypeMobile},
+		{"258841234567", "mz", "+258841234567", "MZ", PhoneTypeMobile},
+		{"082 123 4567", "ZA", "+27821234567", "ZA", PhoneTypeMobile},
+		{"+27 11 123 4567", "", "+27111234567", "ZA", PhoneTypeFixedLine},
+		{"+1 (416) 555-0123", "", "+14165550123", "CA", PhoneTypeFixedOrMobile},
+		{"+1 202.555.0123", "", "+12025550123", "US", PhoneTypeFixedOrMobile},
+		{"1 202 555 0123", "US", "+12025550123", "US", PhoneTypeFixedOrMobile},
+		{"+351 912 345 678", "", "+351912345678", "PT", PhoneTypeMobile},
+		{"07911 123456", "GB", "+447911123456", "GB", PhoneTypeMobile},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := ParsePhone(tc.input, tc.region)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.e164, p.E164())
+			assert.Equal(t, tc.country, p.Region)
+			assert.Equal(t, tc.typ, p.Type)
+
+			normalized, err := NormalizePhone(tc.input, tc.region)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.e164, normalized)
+		})
+	}
+
+	errorCases := []struct {
+		input  string
+		region string
+	}{
+		{"+1234567890", ""},
+		{"+258 81 123 4567", ""},
+		{"841234567", ""},
+		{"841234567", "XX"},
+		{"84123456", "MZ"},
+		{"+258 84 abc 4567", ""},
+		{"", "MZ"},
+	}
+	for _, tc := range errorCases {
+		_, err := ParsePhone(tc.input, tc.region)
+		assert.Error(t, err, tc.input)
+	}
+}
+
+func TestPhoneFormat(t *testing.T) {
+	testCases := []struct {
+		format   string
+		input    string
+		expected bool
+	}{
+		{"phone", "+258841234567", true},
+		{"phone", "+1234567890", false},
+		{"phone", "841234567", false},
+		{"phone", "+3225551234", true},
+		{"phone", "+525512345678", true},
+		{"phone", "+32 2 555 12 34", false},
+		{"phone", "+3225551234567890", false},
+		{"phone(mobile)", "+3225551234", false},
+		{"phone(BE)", "+3225551234", false},
+		{"phone(MZ)", "841234567", true},
+		{"phone(MZ)", "+27821234567", false},
+		{"phone(MZ,mobile)", "+258841234567", true},
+		{"phone(MZ,mobile)", "+25821123456", false},
+		{"phone(fixed_line)", "+25821123456", true},
+		{"phone(mobile)", "+12025550123", true},
+		{"phone(fixed_line)", "+12025550123", true},
+		{"phone(phone_region=ZA,phone_type=mobile)", "0821234567", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.format+"/"+tc.input, func(t *testing.T) {
+			name, params, err := parseFormatTag(tc.format)
+			assert.NoError(t, err)
+			fn, ok := lookupFormat(name)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, fn(tc.input, params))
+		})
+	}
+}
+
+func TestPhoneTags(t *testing.T) {
+	g := &Validate{}
+
+	type Contact struct {
+		Mobile *string `json:"mobile" format:"phone" phone_region:"MZ" phone_type:"mobile"`
+		Office *string `json:"office" format:"phone"`
+	}
+
+	assert.NoError(t, g.InspectStruct(Contact{
+		Mobile: toPtr("84 123 4567"),
+		Office: toPtr("+27 11 123 4567"),
+	}))
+
+	t.Run("Fixed line where a mobile is required", func(t *testing.T) {
+		err := g.InspectStruct(Contact{Mobile: toPtr("21123456")})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_PHONE_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "mobile", err.(*Error).Path)
+	})
+
+	t.Run("Unknown region", func(t *testing.T) {
+		type BadRegion struct {
+			Phone *string `json:"phone" format:"phone" phone_region:"XX"`
+		}
+		err := g.InspectStruct(BadRegion{})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+		assert.Equal(t, "phone", err.(*Error).Path)
+	})
+
+	t.Run("Unknown type", func(t *testing.T) {
+		type BadType struct {
+			Phone *string `json:"phone" format:"phone" phone_type:"pager"`
+		}
+		err := g.InspectStruct(BadType{})
+		assert.Error(t, err)
+		assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+	})
+}
+
+func TestRegisterPhoneRegion(t *testing.T) {
+	RegisterPhoneRegion(PhoneRegion{Region: "ZZ", CallingCode: "999", Patterns: []PhonePattern{
+		{Type: PhoneTypeMobile, Pattern: `5\d{5}`},
+	}})
+
+	p, err := ParsePhone("+999512345", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "ZZ", p.Region)
+
+	RegisterPhoneRegion(PhoneRegion{Region: "zz", CallingCode: "999", Patterns: []PhonePattern{
+		{Type: PhoneTypeFixedLine, Pattern: `6\d{5}`},
+	}})
+
+	_, err = ParsePhone("+999512345", "")
+	assert.Error(t, err)
+	p, err = ParsePhone("+999612345", "")
+	assert.NoError(t, err)
+	assert.Equal(t, PhoneTypeFixedLine, p.Type)
+}
@@ -1,6 +1,10 @@
 package godantic
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
 
 type ValidationPlugin interface {
 	Validate() *CustomErr
@@ -19,17 +23,12 @@ func validateDynamicFields(fieldValue interface{}, attr, valueType, tree string)
 	// Perform validation based on the value type
 	switch valueType {
 	case "numeric", "number", "integer", "int":
-		// Check if the value is an integer
-		_, ok := fieldValue.(int)
-		if !ok {
-			// If not, check if it can be converted to an integer
-			floatVal, fok := fieldValue.(float64)
-			if !fok || floatVal != float64(int(floatVal)) {
-				return &Error{
-					ErrType: "INVALID_VALUE_TYPE_ERR",
-					Path:    fullPath,
-					Message: fmt.Sprintf("Invalid value type for field '%s' at path '%s'. Expected numeric value.", attr, fullPath),
-				}
+		// Accept any whole number, including json.Number literals too large for int
+		if !isIntegerValue(fieldValue) {
+			return &Error{
+				ErrType: "INVALID_VALUE_TYPE_ERR",
+				Path:    fullPath,
+				Message: fmt.Sprintf("Invalid value type for field '%s' at path '%s'. Expected numeric value.", attr, fullPath),
 			}
 		}
 	case "string":
@@ -41,7 +40,7 @@ func validateDynamicFields(fieldValue interface{}, attr, valueType, tree string)
 			}
 		}
 	case "float":
-		if _, ok := fieldValue.(float64); !ok {
+		if !isFloatValue(fieldValue) {
 			return &Error{
 				ErrType: "INVALID_VALUE_TYPE_ERR",
 				Path:    fullPath,
@@ -66,3 +65,170 @@ func validateDynamicFields(fieldValue interface{}, attr, valueType, tree string)
 
 	return nil
 }
+
+// ValidationPluginV2 is implemented by values that validate themselves with
+// knowledge of where they sit: their path, the struct holding them and the
+// value being validated. Validate may return an *Error, Errors or any other
+// error, and report more errors through ctx.
+type ValidationPluginV2 interface {
+	Validate(ctx ValidationContext) error
+}
+
+var (
+	pluginType   = reflect.TypeOf((*ValidationPlugin)(nil)).Elem()
+	pluginV2Type = reflect.TypeOf((*ValidationPluginV2)(nil)).Elem()
+)
+
+// ValidationContext is passed to ValidationPluginV2.
+type ValidationContext interface {
+	// Path returns the path of the value, with list indices such as
+	// "items[2]" or "items[2].price", "" at the root.
+	Path() string
+	// Parent returns the struct that holds the value, directly or in a
+	// list, or nil at the root.
+	Parent() any
+	// Root returns the value passed to InspectStruct or BindJSON.
+	Root() any
+	// Options returns the options of the validator.
+	Options() ValidationOptions
+	// ReportError reports an error on field, a path relative to the value,
+	// or on the value itself if field is "". It can be called several times;
+	// in FirstError mode only the first error is returned.
+	ReportError(field, errType, message string)
+}
+
+// ValidationOptions are the options of the validator running a plugin.
+type ValidationOptions struct {
+	IgnoreRequired     bool
+	IgnoreMinLen       bool
+	AllowUnknownFields bool
+	Locale             string
+	ErrorMode          ErrorMode
+}
+
+type validationContext struct {
+	path    string
+	parent  any
+	root    any
+	options ValidationOptions
+	errs    Errors
+}
+
+func (c *validationContext) Path() string               { return c.path }
+func (c *validationContext) Parent() any                { return c.parent }
+func (c *validationContext) Root() any                  { return c.root }
+func (c *validationContext) Options() ValidationOptions { return c.options }
+
+func (c *validationContext) ReportError(field, errType, message string) {
+	path := joinPath(c.path, field)
+	if message == "" {
+		message = fmt.Sprintf("The field <%s> is invalid", path)
+	}
+	c.errs = append(c.errs, &Error{ErrType: errType, Path: path, Message: message})
+}
+
+// add adds an error returned by a plugin; errors without a path are placed
+// on the value.
+func (c *validationContext) add(err error) {
+	var errs Errors
+	switch e := err.(type) {
+	case *Error:
+		if e == nil {
+			return
+		}
+		errs = Errors{e}
+	case Errors:
+		errs = e
+	case *CustomErr:
+		if e == nil {
+			return
+		}
+		errs = Errors{{ErrType: e.ErrType, Message: e.Message, Path: e.Path, err: e}}
+	default:
+		errs = Errors{{ErrType: "VALIDATION_ERR", Message: err.Error(), err: err}}
+	}
+	for _, e := range errs {
+		if e.Path == "" {
+			e.Path = c.path
+		}
+		c.errs = append(c.errs, e)
+	}
+}
+
+// validatePluginV2 runs the ValidationPluginV2 of v, if any. path is the
+// path of v without list indices.
+func (g *Validate) validatePluginV2(v reflect.Value, path string) error {
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return nil
+	}
+	if v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface {
+		if !reflect.PtrTo(v.Type()).Implements(pluginV2Type) {
+			return nil
+		}
+		// pointer receivers are found on an addressable copy
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p
+	}
+	plugin, ok := resolveInterface[ValidationPluginV2](v)
+	if !ok {
+		return nil
+	}
+	ctx := &validationContext{
+		path: g.indexedPath(path),
+		root: g.root,
+		options: ValidationOptions{
+			IgnoreRequired:     g.IgnoreRequired,
+			IgnoreMinLen:       g.IgnoreMinLen,
+			AllowUnknownFields: g.AllowUnknownFields,
+			Locale:             g.locale,
+			ErrorMode:          g.errorMode,
+		},
+	}
+	if n := len(g.parents); n > 0 {
+		ctx.parent = g.parents[n-1].Interface()
+	}
+	if err := plugin.Validate(ctx); err != nil {
+		ctx.add(err)
+	}
+	switch {
+	case len(ctx.errs) == 0:
+		return nil
+	case g.errorMode == AllErrors:
+		return ctx.errs
+	default:
+		return ctx.errs[0]
+	}
+}
+
+// isInspectedStruct reports whether v is a struct whose fields are checked
+// one by one, so its plugins run in checkStruct.
+func isInspectedStruct(v reflect.Value) bool {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	return v.Kind() == reflect.Struct && !isValueType(v.Type()) && !isTime(v)
+}
+
+type pathIndex struct {
+	plain   string
+	indexed string
+}
+
+// indexedPath adds the indices of the list items being checked to path,
+// turning "items.price" into "items[2].price".
+func (g *Validate) indexedPath(path string) string {
+	for i := len(g.indices) - 1; i >= 0; i-- {
+		p := g.indices[i]
+		if path == p.plain {
+			return p.indexed
+		}
+		if strings.HasPrefix(path, p.plain+".") {
+			return p.indexed + path[len(p.plain):]
+		}
+	}
+	return path
+}
@@ -0,0 +1,122 @@
+package godantic
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type v2Price float64
+
+// Validate rejects prices above the limit of the order holding the line.
+func (p v2Price) Validate(ctx ValidationContext) error {
+	if p <= 0 {
+		return errors.New("price must be positive")
+	}
+	if order, ok := ctx.Root().(*v2Order); ok && order.Limit != nil && float64(p) > *order.Limit {
+		return &Error{ErrType: "OVER_LIMIT_ERR", Message: "price is over the order limit"}
+	}
+	return nil
+}
+
+type v2Tag string
+
+func (t v2Tag) Validate(ctx ValidationContext) error {
+	if strings.ToLower(string(t)) != string(t) {
+		ctx.ReportError("", "TAG_CASE_ERR", "")
+	}
+	return nil
+}
+
+type v2Line struct {
+	SKU   *string  `json:"sku"`
+	Qty   *int     `json:"qty"`
+	Max   *int     `json:"max"`
+	Price *v2Price `json:"price"`
+}
+
+func (l *v2Line) Validate(ctx ValidationContext) error {
+	if l.SKU == nil {
+		ctx.ReportError("sku", "SKU_ERR", "")
+	}
+	if l.Qty != nil && l.Max != nil && *l.Qty > *l.Max {
+		ctx.ReportError("qty", "QTY_ERR", "qty is over max")
+	}
+	if _, ok := ctx.Parent().(v2Order); !ok {
+		ctx.ReportError("", "PARENT_ERR", "")
+	}
+	return nil
+}
+
+type v2Order struct {
+	Limit *float64  `json:"limit"`
+	Lines *[]v2Line `json:"lines"`
+	Tags  *[]v2Tag  `json:"tags"`
+	Extra []v2Line  `json:"extra"`
+	Codes []v2Tag   `json:"codes"`
+}
+
+func TestValidationPluginV2(t *testing.T) {
+	testCases := []struct {
+		name    string
+		data    string
+		errType string
+		path    string
+	}{
+		{"Valid", `{"limit":10,"lines":[{"sku":"a","qty":1,"max":2,"price":5}],"tags":["a","b"]}`, "", ""},
+		{"Returned error", `{"lines":[{"sku":"a","price":1},{"sku":"b","price":-1}]}`, "VALIDATION_ERR", "lines[1].price"},
+		{"Root", `{"limit":10,"lines":[{"sku":"a","price":11}]}`, "OVER_LIMIT_ERR", "lines[0].price"},
+		{"Reported error", `{"lines":[{"sku":"a"},{"sku":"b","qty":3,"max":2}]}`, "QTY_ERR", "lines[1].qty"},
+		{"Value in a list", `{"tags":["a","B"]}`, "TAG_CASE_ERR", "tags[1]"},
+		{"List held by value", `{"extra":[{"sku":"a"},{"sku":"b","qty":3,"max":2}]}`, "QTY_ERR", "extra[1].qty"},
+		{"Value in a list held by value", `{"codes":["a","B"]}`, "TAG_CASE_ERR", "codes[1]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := (&Validate{}).BindJSON([]byte(tc.data), &v2Order{})
+			if tc.errType == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				e := err.(*Error)
+				assert.Equal(t, tc.errType, e.ErrType, e.Message)
+				assert.Equal(t, tc.path, e.Path)
+			}
+		})
+	}
+
+	t.Run("Several errors", func(t *testing.T) {
+		data := []byte(`{"lines":[{"qty":3,"max":2}]}`)
+		err := (&Validate{}).BindJSON(data, &v2Order{})
+		assert.Equal(t, "lines[0].sku", err.(*Error).Path)
+
+		err = New(WithErrorMode(AllErrors)).BindJSON(data, &v2Order{})
+		if errs, ok := err.(Errors); assert.True(t, ok, "%T", err) {
+			assert.Len(t, errs, 2)
+			assert.Equal(t, "lines[0].sku", errs[0].Path)
+			assert.Equal(t, "lines[0].qty", errs[1].Path)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		var seen ValidationOptions
+		plugin := optionsPlugin{seen: &seen}
+		assert.NoError(t, New(IgnoreRequired(), WithLocale("pt")).InspectStruct(struct {
+			P *optionsPlugin `json:"p"`
+		}{P: &plugin}))
+		assert.True(t, seen.IgnoreRequired)
+		assert.Equal(t, "pt", seen.Locale)
+	})
+}
+
+type optionsPlugin struct {
+	seen *ValidationOptions
+}
+
+func (p optionsPlugin) Validate(ctx ValidationContext) error {
+	*p.seen = ctx.Options()
+	return nil
+}
@@ -14,6 +14,10 @@ func (g *Validate) checkMinMax(f reflect.StructField, v reflect.Value, tree stri
 		}
 		v = v.Elem()
 	}
+	if isValue(v) {
+		// value types take literals, see checkValueBounds
+		return nil
+	}
 
 	minTag := f.Tag.Get("min")
 	maxTag := f.Tag.Get("max")
@@ -63,6 +67,28 @@ func (g *Validate) checkMinMax(f reflect.StructField, v reflect.Value, tree stri
 				}
 			}
 		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		val := v.Uint()
+		if minTag != "" {
+			min, err := strconv.ParseUint(minTag, 10, 64)
+			if err == nil && val < min {
+				return &Error{
+					ErrType: "MIN_VALUE_ERR",
+					Path:    fieldName(f, tree),
+					Message: fmt.Sprintf("The field <%s> must be at least %d, but was %d", fieldName(f, tree), min, val),
+				}
+			}
+		}
+		if maxTag != "" {
+			max, err := strconv.ParseUint(maxTag, 10, 64)
+			if err == nil && val > max {
+				return &Error{
+					ErrType: "MAX_VALUE_ERR",
+					Path:    fieldName(f, tree),
+					Message: fmt.Sprintf("The field <%s> must be at most %d, but was %d", fieldName(f, tree), max, val),
+				}
+			}
+		}
 	case reflect.Float32, reflect.Float64:
 		val := v.Float()
 		if minTag != "" {
@@ -98,7 +124,7 @@ func (g *Validate) checkNumericConstraints(f reflect.StructField, v reflect.Valu
 	}
 
 	// Skip if not numeric
-	if !(v.Kind() >= reflect.Int && v.Kind() <= reflect.Float64) {
+	if !(v.Kind() >= reflect.Int && v.Kind() <= reflect.Float64) || isValue(v) {
 		return nil
 	}
 
@@ -110,12 +136,16 @@ func (g *Validate) checkNumericConstraints(f reflect.StructField, v reflect.Valu
 	multipleOfTag := f.Tag.Get("multiple_of")
 	allowInfNaNTag := f.Tag.Get("allow_inf_nan")
 
+	if exact, ok := exactValue(v); ok {
+		// Integers are compared exactly, float64 can't hold every int64.
+		if err := checkBounds(f, exact, decimalType, tree, comparisonTags); err != nil {
+			return err
+		}
+		return g.checkMultipleOf(f, exact, tree)
+	}
+
 	var value float64
 	switch v.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		value = float64(v.Int())
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		value = float64(v.Uint())
 	case reflect.Float32, reflect.Float64:
 		value = v.Float()
 		if allowInfNaNTag != "true" && (math.IsNaN(value) || math.IsInf(value, 0)) {
@@ -178,3 +208,35 @@ func (g *Validate) checkNumericConstraints(f reflect.StructField, v reflect.Valu
 
 	return nil
 }
+
+// checkUnsignedConfig reports bounds on unsigned fields that can't be
+// compared with the field: malformed numbers, negative numbers, and min/max
+// values outside the field's type.
+func checkUnsignedConfig(f reflect.StructField) string {
+	t := f.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() < reflect.Uint || t.Kind() > reflect.Uintptr {
+		return ""
+	}
+	for _, tag := range []string{"min", "max"} {
+		if bound, ok := f.Tag.Lookup(tag); ok {
+			if _, err := strconv.ParseUint(bound, 10, t.Bits()); err != nil {
+				return fmt.Sprintf("%s: '%s' is not a valid %s", tag, bound, t.Kind())
+			}
+		}
+	}
+	for _, tag := range []string{"gt", "ge", "lt", "le", "multiple_of"} {
+		if bound, ok := f.Tag.Lookup(tag); ok {
+			d, err := ParseDecimal(bound)
+			if err != nil {
+				return fmt.Sprintf("%s: '%s' is not a number", tag, bound)
+			}
+			if d.Sign() < 0 {
+				return fmt.Sprintf("%s: '%s' is negative, but the field is unsigned", tag, bound)
+			}
+		}
+	}
+	return ""
+}
@@ -51,6 +51,79 @@ func TestMinMaxValidation(t *testing.T) {
 		assert.NoError(t, g.InspectStruct(TestStruct{Age: &valid}))
 	})
 
+	t.Run("Unsigned value constraints", func(t *testing.T) {
+		type TestStruct struct {
+			Port *uint32 `json:"port" min:"1024" max:"65535"`
+		}
+
+		low := uint32(80)
+		high := uint32(70000)
+		valid := uint32(8080)
+
+		err := g.InspectStruct(TestStruct{Port: &low})
+		assert.Error(t, err)
+		assert.Equal(t, "MIN_VALUE_ERR", err.(*Error).ErrType)
+		err = g.InspectStruct(TestStruct{Port: &high})
+		assert.Error(t, err)
+		assert.Equal(t, "MAX_VALUE_ERR", err.(*Error).ErrType)
+		assert.NoError(t, g.InspectStruct(TestStruct{Port: &valid}))
+	})
+
+	t.Run("Exact uint64 constraints", func(t *testing.T) {
+		type TestStruct struct {
+			ID *uint64 `json:"id" max:"18446744073709551614" gt:"9007199254740992" multiple_of:"3"`
+		}
+
+		// Above 2^53 neighbouring values share a float64, so these are only
+		// told apart by exact comparisons.
+		tooBig := uint64(math.MaxUint64)
+		notAbove := uint64(9007199254740992)
+		notMultiple := uint64(9007199254740994)
+		valid := uint64(9007199254740993)
+
+		err := g.InspectStruct(TestStruct{ID: &tooBig})
+		assert.Error(t, err)
+		assert.Equal(t, "MAX_VALUE_ERR", err.(*Error).ErrType)
+		err = g.InspectStruct(TestStruct{ID: &notAbove})
+		assert.Error(t, err)
+		assert.Equal(t, "GREATER_THAN_ERR", err.(*Error).ErrType)
+		err = g.InspectStruct(TestStruct{ID: &notMultiple})
+		assert.Error(t, err)
+		assert.Equal(t, "NOT_MULTIPLE_ERR", err.(*Error).ErrType)
+		assert.NoError(t, g.InspectStruct(TestStruct{ID: &valid}))
+	})
+
+	t.Run("Invalid unsigned bounds", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			value any
+		}{
+			{"Negative min", struct {
+				N *uint `json:"n" min:"-1"`
+			}{}},
+			{"Malformed max", struct {
+				N *uint16 `json:"n" max:"ten"`
+			}{}},
+			{"max outside uint8", struct {
+				N *uint8 `json:"n" max:"300"`
+			}{}},
+			{"Negative ge", struct {
+				N *uint `json:"n" ge:"-5"`
+			}{}},
+			{"Malformed multiple_of", struct {
+				N *uint `json:"n" multiple_of:"x"`
+			}{}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := g.InspectStruct(tc.value)
+				assert.Error(t, err)
+				assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+				assert.Equal(t, "n", err.(*Error).Path)
+			})
+		}
+	})
+
 	t.Run("Float value constraints", func(t *testing.T) {
 		type TestStruct struct {
 			Score *float64 `json:"score" min:"1.5" max:"3.5"`
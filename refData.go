@@ -1,11 +1,20 @@
 package godantic
 
 import (
+	"encoding/json"
 	"reflect"
 	"strings"
+	"time"
 )
 
 func buildRefData(v any) map[string]any {
+	return buildRefDataSeen(v, make(map[reflect.Type]bool))
+}
+
+// buildRefDataSeen builds the reference data of v. seen holds the struct
+// types being built, so the items of a list of a type that holds it are
+// left unchecked instead of expanded without end.
+func buildRefDataSeen(v any, seen map[reflect.Type]bool) map[string]any {
 	val := reflect.ValueOf(v)
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
@@ -13,6 +22,8 @@ func buildRefData(v any) map[string]any {
 
 	typ := val.Type()
 	result := make(map[string]any)
+	seen[typ] = true
+	defer delete(seen, typ)
 
 	for i := 0; i < val.NumField(); i++ {
 		field := typ.Field(i)
@@ -20,7 +31,7 @@ func buildRefData(v any) map[string]any {
 		fieldType := field.Type
 
 		jsonTag := field.Tag.Get("json")
-		if jsonTag == "-" {
+		if jsonTag == "-" || field.PkgPath != "" && !field.Anonymous {
 			continue
 		}
 		fieldName := strings.Split(jsonTag, ",")[0]
@@ -32,13 +43,39 @@ func buildRefData(v any) map[string]any {
 		case fieldType == reflect.TypeOf(Object{}):
 			result[fieldName] = Object{}
 
+		case fieldType == TimeType || fieldType == reflect.PtrTo(TimeType):
+			result[fieldName] = time.Time{}
+
+		case isValueType(fieldType) || fieldType.Kind() == reflect.Ptr && isValueType(fieldType.Elem()):
+			// value types are compared by the JSON they marshal to
+			valueType := fieldType
+			if valueType.Kind() == reflect.Ptr {
+				valueType = valueType.Elem()
+			}
+			var shape any
+			data, _ := json.Marshal(reflect.Zero(valueType).Interface())
+			_ = json.Unmarshal(data, &shape)
+			result[fieldName] = shape
+
 		case fieldType.Kind() == reflect.Struct:
-			result[fieldName] = buildRefData(fieldVal.Interface())
+			result[fieldName] = buildRefDataSeen(fieldVal.Interface(), seen)
+
+		case fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Struct:
+			// Built from the value rather than the type, so self-referential
+			// types end at the first nil pointer.
+			if fieldVal.IsNil() {
+				result[fieldName] = reflect.Zero(fieldType).Interface()
+			} else {
+				result[fieldName] = buildRefDataSeen(fieldVal.Interface(), seen)
+			}
 
-		case fieldType.Kind() == reflect.Slice:
+		case fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Slice:
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
 			slice := []any{}
-			if fieldType.Elem().Kind() == reflect.Struct {
-				slice = append(slice, buildRefData(reflect.New(fieldType.Elem()).Interface()))
+			if elem := fieldType.Elem(); elem.Kind() == reflect.Struct && !seen[elem] {
+				slice = append(slice, buildRefDataSeen(reflect.New(elem).Interface(), seen))
 			}
 			result[fieldName] = slice
 
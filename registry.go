@@ -0,0 +1,255 @@
+package godantic
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// Registry holds the formats, custom validators, struct validators,
+// transformers and messages a Validate uses. The package-level Register functions fill the
+// default registry, which a Validate uses unless its Registry is set.
+//
+// A registry created with a fallback looks up what it doesn't hold in the
+// fallback, so it can add to or override the default registry without
+// changing it:
+//
+//	r := godantic.NewRegistry(godantic.DefaultRegistry())
+//	r.RegisterFormat("slug", isSlug)
+//	v := godantic.NewValidator(godantic.WithRegistry(r))
+type Registry struct {
+	fallback *Registry
+
+	mu      sync.RWMutex
+	formats map[string]FormatFunc
+	custom  map[reflect.Type]map[string]customValidator
+	structs map[reflect.Type]structValidatorFunc
+	// transformers are used by the `transform` tag.
+	transformers map[string]TransformFunc
+	// messages maps locales, "" for any, to error types.
+	messages map[string]map[string]MessageFunc
+}
+
+// MessageFunc returns the message of an error, replacing the built-in one.
+type MessageFunc func(e *Error) string
+
+var defaultRegistry = NewRegistry(nil)
+
+// DefaultRegistry returns the registry filled by the package-level Register
+// functions.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewRegistry returns an empty registry. When fallback is not nil, lookups
+// that miss in the new registry continue there.
+func NewRegistry(fallback *Registry) *Registry {
+	return &Registry{
+		fallback:     fallback,
+		formats:      make(map[string]FormatFunc),
+		custom:       make(map[reflect.Type]map[string]customValidator),
+		structs:      make(map[reflect.Type]structValidatorFunc),
+		transformers: make(map[string]TransformFunc),
+		messages:     make(map[string]map[string]MessageFunc),
+	}
+}
+
+// Clone returns a copy of r with the same fallback. Registering in the copy
+// leaves r unchanged, e.g. to mock a validator in a single test.
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c := NewRegistry(r.fallback)
+	for name, fn := range r.formats {
+		c.formats[name] = fn
+	}
+	for t, validators := range r.custom {
+		c.custom[t] = make(map[string]customValidator, len(validators))
+		for name, validator := range validators {
+			c.custom[t][name] = validator
+		}
+	}
+	for t, fn := range r.structs {
+		c.structs[t] = fn
+	}
+	for name, fn := range r.transformers {
+		c.transformers[name] = fn
+	}
+	for locale, messages := range r.messages {
+		c.messages[locale] = make(map[string]MessageFunc, len(messages))
+		for errType, fn := range messages {
+			c.messages[locale][errType] = fn
+		}
+	}
+	return c
+}
+
+// RegisterFormat makes fn available to the `format` tag under name.
+func (r *Registry) RegisterFormat(name string, fn func(string) bool) {
+	r.RegisterFormatWithParams(name, func(value string, _ []string) bool {
+		return fn(value)
+	})
+}
+
+// RegisterFormatWithParams registers a format that receives the arguments
+// given in the tag.
+func (r *Registry) RegisterFormatWithParams(name string, fn FormatFunc) {
+	r.mu.Lock()
+	r.formats[name] = fn
+	r.mu.Unlock()
+	resetTypeConfigs()
+}
+
+// RegisterFormatRegex registers a format backed by a regular expression,
+// panicking if it is invalid.
+func (r *Registry) RegisterFormatRegex(name, pattern string) {
+	r.RegisterFormat(name, regexp.MustCompile(pattern).MatchString)
+}
+
+// RegisterTransformer makes fn available to the `transform` tag under name.
+func (r *Registry) RegisterTransformer(name string, fn TransformFunc) {
+	r.mu.Lock()
+	r.transformers[name] = fn
+	r.mu.Unlock()
+	resetTypeConfigs()
+}
+
+// RegisterMessage replaces the message of the errors of type errType, e.g.
+// to translate them.
+func (r *Registry) RegisterMessage(errType string, fn MessageFunc) {
+	r.RegisterLocaleMessage("", errType, fn)
+}
+
+// RegisterLocaleMessage replaces the message of the errors of type errType
+// for validators using locale, see WithLocale. It takes precedence over a
+// message registered for any locale.
+func (r *Registry) RegisterLocaleMessage(locale, errType string, fn MessageFunc) {
+	r.mu.Lock()
+	if r.messages[locale] == nil {
+		r.messages[locale] = make(map[string]MessageFunc)
+	}
+	r.messages[locale][errType] = fn
+	r.mu.Unlock()
+}
+
+// Formats returns the names usable in the `format` tag, sorted, including
+// those of the fallback.
+func (r *Registry) Formats() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for ; r != nil; r = r.fallback {
+		r.mu.RLock()
+		for name := range r.formats {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		r.mu.RUnlock()
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterMessage replaces the message of the errors of type errType in the
+// default registry.
+func RegisterMessage(errType string, fn MessageFunc) {
+	defaultRegistry.RegisterMessage(errType, fn)
+}
+
+// RegisterCustomIn is RegisterCustom for registry r.
+func RegisterCustomIn[T any](r *Registry, tag string, fn func(T, string) *Error) {
+	registerCustom[T](r, tag, customValidator{}, func(v T, _ Args, path string) *Error {
+		return fn(v, path)
+	})
+}
+
+// RegisterCustomWithArgsIn is RegisterCustomWithArgs for registry r.
+func RegisterCustomWithArgsIn[T any](r *Registry, name string, fn func(v T, args Args, path string) *Error, params ...ArgType) {
+	registerCustom[T](r, name, customValidator{takesArgs: true, params: params}, fn)
+}
+
+// RegisterStructValidatorIn is RegisterStructValidator for registry r.
+func RegisterStructValidatorIn[T any](r *Registry, fn func(v T, sl StructLevel)) {
+	var zero T
+	r.mu.Lock()
+	r.structs[reflect.TypeOf(zero)] = func(v any, sl StructLevel) {
+		fn(v.(T), sl)
+	}
+	r.mu.Unlock()
+}
+
+func (r *Registry) lookupFormat(name string) (FormatFunc, bool) {
+	for ; r != nil; r = r.fallback {
+		r.mu.RLock()
+		fn, ok := r.formats[name]
+		r.mu.RUnlock()
+		if ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+func (r *Registry) customValidator(t reflect.Type, tag string) (customValidator, bool) {
+	for ; r != nil; r = r.fallback {
+		r.mu.RLock()
+		validator, ok := r.custom[t][tag]
+		r.mu.RUnlock()
+		if ok {
+			return validator, true
+		}
+	}
+	return customValidator{}, false
+}
+
+func (r *Registry) structValidator(t reflect.Type) (structValidatorFunc, bool) {
+	for ; r != nil; r = r.fallback {
+		r.mu.RLock()
+		fn, ok := r.structs[t]
+		r.mu.RUnlock()
+		if ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+func (r *Registry) transformer(name string) (TransformFunc, bool) {
+	for ; r != nil; r = r.fallback {
+		r.mu.RLock()
+		fn, ok := r.transformers[name]
+		r.mu.RUnlock()
+		if ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+func (r *Registry) message(locale, errType string) (MessageFunc, bool) {
+	locales := []string{""}
+	if locale != "" {
+		locales = []string{locale, ""}
+	}
+	for _, locale := range locales {
+		for reg := r; reg != nil; reg = reg.fallback {
+			reg.mu.RLock()
+			fn, ok := reg.messages[locale][errType]
+			reg.mu.RUnlock()
+			if ok {
+				return fn, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (g *Validate) registry() *Registry {
+	if g.Registry != nil {
+		return g.Registry
+	}
+	return defaultRegistry
+}
@@ -0,0 +1,130 @@
+package godantic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type regPost struct {
+	Slug  *string `json:"slug" validate:"slug"`
+	Email *string `json:"email" format:"email"`
+	Code  *string `json:"code" format:"reg-code"`
+}
+
+type regSpan struct {
+	From *int `json:"from"`
+	To   *int `json:"to"`
+}
+
+func errType(err error) string {
+	if e, ok := err.(*Error); ok {
+		return e.ErrType
+	}
+	return ""
+}
+
+func TestRegistry(t *testing.T) {
+	lower := NewRegistry(DefaultRegistry())
+	RegisterCustomIn(lower, "slug", func(s string, path string) *Error {
+		if s != strings.ToLower(s) {
+			return &Error{ErrType: "LOWER_SLUG_ERR", Path: path, Message: "slug must be lowercase"}
+		}
+		return nil
+	})
+	lower.RegisterFormatRegex("reg-code", `^[a-z]+$`)
+
+	short := NewRegistry(DefaultRegistry())
+	RegisterCustomIn(short, "slug", func(s string, path string) *Error {
+		if len(s) > 3 {
+			return &Error{ErrType: "SHORT_SLUG_ERR", Path: path, Message: "slug is too long"}
+		}
+		return nil
+	})
+	short.RegisterFormatRegex("reg-code", `^[0-9]+$`)
+
+	post := regPost{Slug: toPtr("Abcd"), Email: toPtr("a@b.co"), Code: toPtr("abc")}
+
+	t.Run("Instances don't clobber each other", func(t *testing.T) {
+		assert.Equal(t, "LOWER_SLUG_ERR", errType(NewValidator(WithRegistry(lower)).InspectStruct(post)))
+		assert.Equal(t, "SHORT_SLUG_ERR", errType(NewValidator(WithRegistry(short)).InspectStruct(post)))
+	})
+
+	t.Run("Not registered in the default registry", func(t *testing.T) {
+		assert.Equal(t, "INVALID_CONFIG_ERR", errType((&Validate{}).InspectStruct(post)))
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		p := post
+		p.Slug, p.Email = toPtr("abc"), toPtr("nope")
+		assert.Equal(t, "INVALID_EMAIL_ERR", errType(NewValidator(WithRegistry(lower)).InspectStruct(p)))
+
+		isolated := lower.Clone()
+		isolated.fallback = nil
+		assert.Equal(t, "INVALID_CONFIG_ERR", errType(NewValidator(WithRegistry(isolated)).InspectStruct(p)))
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		mock := lower.Clone()
+		RegisterCustomIn(mock, "slug", func(string, string) *Error { return nil })
+		assert.NoError(t, NewValidator(WithRegistry(mock)).InspectStruct(post))
+		assert.Equal(t, "LOWER_SLUG_ERR", errType(NewValidator(WithRegistry(lower)).InspectStruct(post)))
+	})
+
+	t.Run("Struct validators", func(t *testing.T) {
+		r := NewRegistry(nil)
+		RegisterStructValidatorIn(r, func(s regSpan, sl StructLevel) {
+			if *s.To < *s.From {
+				sl.ReportError("to", "SPAN_ERR", "")
+			}
+		})
+		span := regSpan{From: toPtr(2), To: toPtr(1)}
+		assert.Equal(t, "SPAN_ERR", errType(NewValidator(WithRegistry(r)).InspectStruct(span)))
+		assert.NoError(t, (&Validate{}).InspectStruct(span))
+	})
+
+	t.Run("Transformers", func(t *testing.T) {
+		type user struct {
+			Name  *string  `json:"name" transform:"trim,title"`
+			Email *string  `json:"email" transform:"trim,lower" format:"email"`
+			Tags  []string `json:"tags" transform:"upper"`
+		}
+		r := NewRegistry(DefaultRegistry())
+		r.RegisterTransformer("title", func(s string) string {
+			return strings.ToUpper(s[:1]) + s[1:]
+		})
+		var u user
+		err := NewValidator(WithRegistry(r)).BindJSON([]byte(`{"name":" ana ","email":" Ana@Example.COM ","tags":["a","b"]}`), &u)
+		assert.NoError(t, err)
+		assert.Equal(t, "Ana", *u.Name)
+		assert.Equal(t, "ana@example.com", *u.Email)
+		assert.Equal(t, []string{"A", "B"}, u.Tags)
+
+		err = (&Validate{}).BindJSON([]byte(`{"name":"ana"}`), &user{})
+		assert.Equal(t, "INVALID_CONFIG_ERR", errType(err))
+
+		mock := r.Clone()
+		mock.RegisterTransformer("title", strings.ToUpper)
+		err = NewValidator(WithRegistry(mock)).BindJSON([]byte(`{"name":"ana"}`), &u)
+		assert.NoError(t, err)
+		assert.Equal(t, "ANA", *u.Name)
+
+		err = (&Validate{}).BindJSON([]byte(`{"n":1}`), &struct {
+			N *int `json:"n" transform:"trim"`
+		}{})
+		assert.Equal(t, "INVALID_CONFIG_ERR", errType(err))
+	})
+
+	t.Run("Messages", func(t *testing.T) {
+		r := lower.Clone()
+		r.RegisterMessage("LOWER_SLUG_ERR", func(e *Error) string {
+			return fmt.Sprintf("<%s> doit être en minuscules", e.Path)
+		})
+		g := NewValidator(WithRegistry(r))
+		err := g.BindJSON([]byte(`{"slug":"Abc"}`), &regPost{})
+		assert.EqualError(t, err, "<slug> doit être en minuscules")
+		assert.EqualError(t, NewValidator(WithRegistry(lower)).InspectStruct(post), "slug must be lowercase")
+	})
+}
@@ -0,0 +1,155 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Rules attaches validation rules to the fields of a struct type without
+// struct tags, for types that can't be tagged, such as generated or vendored
+// ones:
+//
+//	godantic.For[User]().
+//		Field("Email").Required().Format("email").
+//		Field("Age").Gte(18)
+//
+// Each rule stands for the tag of the same name and is validated by the same
+// code, together with the tags the field already has. Rules set with For
+// take precedence over those tags. Naming a field that doesn't exist, or
+// setting a rule before naming a field, panics.
+type Rules[T any] struct {
+	t     reflect.Type
+	field int
+}
+
+type rule struct {
+	tag   string
+	value string
+}
+
+var (
+	// typeRules holds the rules set with For, per struct type and field index.
+	typeRules    = make(map[reflect.Type]map[int][]rule)
+	typeRulesMux sync.RWMutex
+)
+
+// For returns a builder for the rules of struct type T.
+func For[T any]() *Rules[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("godantic: For needs a struct type, %s is not one", t))
+	}
+	return &Rules[T]{t: t, field: -1}
+}
+
+// Field selects the field the following rules apply to, by its Go or JSON
+// name.
+func (r *Rules[T]) Field(name string) *Rules[T] {
+	if f, ok := r.t.FieldByName(name); ok && len(f.Index) == 1 {
+		return &Rules[T]{t: r.t, field: f.Index[0]}
+	}
+	if i, ok := jsonFieldIndex(r.t, name); ok {
+		return &Rules[T]{t: r.t, field: i}
+	}
+	panic(fmt.Sprintf("godantic: %s has no field %s", r.t, name))
+}
+
+// Tag sets the rule of any tag by name, e.g. Tag("time_format", "unix").
+func (r *Rules[T]) Tag(name, value string) *Rules[T] {
+	if r.field < 0 {
+		panic(fmt.Sprintf("godantic: For[%s] sets %s before naming a field", r.t, name))
+	}
+	typeRulesMux.Lock()
+	if typeRules[r.t] == nil {
+		typeRules[r.t] = make(map[int][]rule)
+	}
+	rules := typeRules[r.t][r.field]
+	replaced := false
+	for i := range rules {
+		if rules[i].tag == name {
+			rules[i].value, replaced = value, true
+		}
+	}
+	if !replaced {
+		rules = append(rules, rule{tag: name, value: value})
+	}
+	typeRules[r.t][r.field] = rules
+	typeRulesMux.Unlock()
+
+	resetTypeConfigs()
+	return r
+}
+
+func (r *Rules[T]) Required() *Rules[T]             { return r.Tag("binding", "required") }
+func (r *Rules[T]) Format(format string) *Rules[T]  { return r.Tag("format", format) }
+func (r *Rules[T]) Regex(pattern string) *Rules[T]  { return r.Tag("regex", pattern) }
+func (r *Rules[T]) Enum(values ...string) *Rules[T] { return r.Tag("enum", strings.Join(values, ",")) }
+func (r *Rules[T]) When(condition string) *Rules[T] { return r.Tag("when", condition) }
+func (r *Rules[T]) Each(rules string) *Rules[T]     { return r.Tag("each", rules) }
+func (r *Rules[T]) Validate(names ...string) *Rules[T] {
+	return r.Tag("validate", strings.Join(names, ","))
+}
+
+// Min, Max, Gt, Gte, Lt, Lte and MultipleOf take a number, or a literal of
+// the field's type such as "15m" for a Duration.
+func (r *Rules[T]) Min(bound any) *Rules[T]       { return r.Tag("min", fmt.Sprint(bound)) }
+func (r *Rules[T]) Max(bound any) *Rules[T]       { return r.Tag("max", fmt.Sprint(bound)) }
+func (r *Rules[T]) Gt(bound any) *Rules[T]        { return r.Tag("gt", fmt.Sprint(bound)) }
+func (r *Rules[T]) Gte(bound any) *Rules[T]       { return r.Tag("ge", fmt.Sprint(bound)) }
+func (r *Rules[T]) Lt(bound any) *Rules[T]        { return r.Tag("lt", fmt.Sprint(bound)) }
+func (r *Rules[T]) Lte(bound any) *Rules[T]       { return r.Tag("le", fmt.Sprint(bound)) }
+func (r *Rules[T]) MultipleOf(base any) *Rules[T] { return r.Tag("multiple_of", fmt.Sprint(base)) }
+func (r *Rules[T]) MaxDigits(n int) *Rules[T]     { return r.Tag("max_digits", strconv.Itoa(n)) }
+func (r *Rules[T]) DecimalPlaces(n int) *Rules[T] { return r.Tag("decimal_places", strconv.Itoa(n)) }
+
+// Unique rejects repeated items in a list field, compared by the given JSON
+// keys or, without keys, as a whole.
+func (r *Rules[T]) Unique(keys ...string) *Rules[T] {
+	if len(keys) == 0 {
+		return r.Tag("unique", "true")
+	}
+	return r.Tag("unique", strings.Join(keys, ","))
+}
+
+// Struct registers fn as the struct validator of T, see
+// RegisterStructValidator.
+func (r *Rules[T]) Struct(fn func(v T, sl StructLevel)) *Rules[T] {
+	RegisterStructValidator(fn)
+	return r
+}
+
+// withTypeRules puts the rules set with For on field i of t in front of the
+// field's tags.
+func withTypeRules(t reflect.Type, i int, tag reflect.StructTag) reflect.StructTag {
+	typeRulesMux.RLock()
+	rules := typeRules[t][i]
+	typeRulesMux.RUnlock()
+
+	if len(rules) == 0 {
+		return tag
+	}
+	pairs := make([]string, 0, len(rules)+1)
+	for _, r := range rules {
+		pairs = append(pairs, r.tag+":"+strconv.Quote(r.value))
+	}
+	if tag != "" {
+		pairs = append(pairs, string(tag))
+	}
+	return reflect.StructTag(strings.Join(pairs, " "))
+}
+
+// resetTypeRules drops the rules set with For on t, and the struct validator
+// set with Struct, so tests can undo them.
+func resetTypeRules(t reflect.Type) {
+	typeRulesMux.Lock()
+	delete(typeRules, t)
+	typeRulesMux.Unlock()
+
+	defaultRegistry.mu.Lock()
+	delete(defaultRegistry.structs, t)
+	defaultRegistry.mu.Unlock()
+	resetTypeConfigs()
+}
@@ -0,0 +1,96 @@
+package godantic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ruleUser stands for a generated type whose tags can't be edited.
+type ruleUser struct {
+	Email *string     `json:"email"`
+	Age   *int        `json:"age"`
+	Role  *string     `json:"role"`
+	Nick  *string     `json:"nick" max:"5"`
+	Tags  *[]string   `json:"tags"`
+	Start *int        `json:"start"`
+	End   *int        `json:"end"`
+	Team  *ruleTeam   `json:"team"`
+	Pets  *[]ruleTeam `json:"pets"`
+}
+
+type ruleTeam struct {
+	Name *string `json:"name"`
+}
+
+func TestForRules(t *testing.T) {
+	For[ruleUser]().
+		Field("Email").Required().Format("email").
+		Field("Age").Gte(18).
+		Field("role").Enum("admin", "member").
+		Field("Nick").Max(3).
+		Field("Tags").Unique().Each("min=2").
+		Field("Pets").Unique("name").
+		Struct(func(u ruleUser, sl StructLevel) {
+			if u.Start != nil && u.End != nil && *u.End < *u.Start {
+				sl.ReportError("end", "PERIOD_ERR", "end must not be before start")
+			}
+		})
+	For[ruleTeam]().Field("Name").Required()
+	defer func() {
+		resetTypeRules(reflect.TypeOf(ruleUser{}))
+		resetTypeRules(reflect.TypeOf(ruleTeam{}))
+	}()
+
+	g := &Validate{}
+	testCases := []struct {
+		name    string
+		data    string
+		errType string
+		path    string
+	}{
+		{"Valid", `{"email":"a@b.co","age":18,"role":"admin","nick":"abc","tags":["go","ml"]}`, "", ""},
+		{"Required", `{"age":20}`, "REQUIRED_FIELD_ERR", "email"},
+		{"Format", `{"email":"nope"}`, "INVALID_EMAIL_ERR", "email"},
+		{"Gte", `{"email":"a@b.co","age":17}`, "GREATER_EQUAL_ERR", "age"},
+		{"Enum", `{"email":"a@b.co","role":"root"}`, "INVALID_ENUM_ERR", "role"},
+		{"Rule over tag", `{"email":"a@b.co","nick":"abcd"}`, "MAX_LENGTH_ERR", "nick"},
+		{"Unique", `{"email":"a@b.co","tags":["go","go"]}`, "DUPLICATE_ITEM_ERR", "tags[1]"},
+		{"Each", `{"email":"a@b.co","tags":["g"]}`, "MIN_LENGTH_ERR", "tags[0]"},
+		{"Unique by key", `{"email":"a@b.co","pets":[{"name":"x"},{"name":"x"}]}`, "DUPLICATE_ITEM_ERR", "pets[1].name"},
+		{"Nested type", `{"email":"a@b.co","team":{}}`, "REQUIRED_FIELD_ERR", "team.name"},
+		{"Struct rule", `{"email":"a@b.co","start":3,"end":1}`, "PERIOD_ERR", "end"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var u ruleUser
+			err := g.BindJSON([]byte(tc.data), &u)
+			if tc.errType == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				e := err.(*Error)
+				assert.Equal(t, tc.errType, e.ErrType, e.Message)
+				assert.Equal(t, tc.path, e.Path)
+			}
+		})
+	}
+
+	t.Run("Invalid rule", func(t *testing.T) {
+		type badRule struct {
+			Code *string `json:"code"`
+		}
+		For[badRule]().Field("Code").Format("no-such-format")
+		err := g.InspectStruct(badRule{Code: toPtr("x")})
+		if assert.Error(t, err) {
+			assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+		}
+	})
+
+	t.Run("Unknown field", func(t *testing.T) {
+		assert.Panics(t, func() { For[ruleTeam]().Field("Missing") })
+		assert.Panics(t, func() { For[ruleTeam]().Required() })
+	})
+}
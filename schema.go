@@ -0,0 +1,250 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Schema describes a JSON object whose shape is only known at runtime, e.g.
+// a form defined by an operator and stored in a database. It is built in
+// code or loaded from JSON with ParseSchema; its fields carry yaml tags too,
+// so any YAML decoder can load it.
+//
+// A schema is compiled into a struct type the first time it is used, with
+// each field's rules as its tags, so payloads are checked by the same code
+// and produce the same errors as struct validation. Don't change a schema
+// after it has been used.
+type Schema struct {
+	Fields []SchemaField `json:"fields" yaml:"fields"`
+
+	once sync.Once
+	typ  reflect.Type
+	err  error
+}
+
+// SchemaField is a field of a Schema. Type is one of string, integer,
+// number, boolean, decimal, time, object, list or any. Rules are tags by
+// name, e.g. {"min": "3", "format": "email", "when": "kind=company"}.
+//
+// Objects hold Fields; an object without fields accepts any keys. Lists hold
+// their Items, whose Rules apply to each item; in a list of lists, only the
+// innermost items take rules.
+type SchemaField struct {
+	Name     string            `json:"name" yaml:"name"`
+	Type     string            `json:"type" yaml:"type"`
+	Required bool              `json:"required,omitempty" yaml:"required,omitempty"`
+	Rules    map[string]string `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Fields   []SchemaField     `json:"fields,omitempty" yaml:"fields,omitempty"`
+	Items    *SchemaField      `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+var schemaTypes = map[string]reflect.Type{
+	"string":  reflect.TypeOf(""),
+	"integer": reflect.TypeOf(int64(0)),
+	"number":  reflect.TypeOf(float64(0)),
+	"boolean": reflect.TypeOf(false),
+	"decimal": reflect.TypeOf(Decimal{}),
+	"time":    reflect.TypeOf(time.Time{}),
+}
+
+// isSchemaRule reports whether name is a tag the `godantic` tag stands for,
+// the rules a schema field accepts.
+func isSchemaRule(name string) bool {
+	if name == "enums" {
+		return true
+	}
+	for _, key := range godanticKeys {
+		if key.tag == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseSchema loads a schema from JSON.
+func ParseSchema(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, &Error{
+			ErrType: "INVALID_SCHEMA_ERR",
+			Message: fmt.Sprintf("The schema is not valid JSON: %v", err),
+		}
+	}
+	return &s, nil
+}
+
+// ValidateMap checks payload against schema s, with the options of g.
+func (g *Validate) ValidateMap(s *Schema, payload map[string]any) error {
+	s.once.Do(func() {
+		s.typ, s.err = schemaStruct(s.Fields, "")
+	})
+	if s.err != nil {
+		return s.err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return &Error{
+			ErrType: "INVALID_JSON_ERR",
+			Message: fmt.Sprintf("The given data can't be encoded as JSON: %v", err),
+		}
+	}
+	return g.BindJSON(data, reflect.New(s.typ).Interface())
+}
+
+func schemaError(path, format string, args ...any) *Error {
+	return &Error{
+		ErrType: "INVALID_SCHEMA_ERR",
+		Path:    path,
+		Message: fmt.Sprintf("Invalid schema field <%s>: %s", path, fmt.Sprintf(format, args...)),
+	}
+}
+
+// schemaStruct builds the struct type standing for fields. Its fields are
+// pointers, so absent keys can be told from zero values.
+func schemaStruct(fields []SchemaField, tree string) (reflect.Type, error) {
+	seen := make(map[string]bool)
+	goNames := make(map[string]bool)
+	structFields := make([]reflect.StructField, 0, len(fields))
+	for i, sf := range fields {
+		path := sf.Name
+		if tree != "" {
+			path = tree + "." + sf.Name
+		}
+		switch {
+		case sf.Name == "":
+			return nil, schemaError(path, "field %d has no name", i)
+		case seen[sf.Name]:
+			return nil, schemaError(path, "the name is used twice")
+		}
+		seen[sf.Name] = true
+
+		t, err := schemaType(sf, path)
+		if err != nil {
+			return nil, err
+		}
+		tag, err := schemaTag(sf, path)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind() != reflect.Interface && t.Kind() != reflect.Map {
+			t = reflect.PtrTo(t)
+		}
+		structFields = append(structFields, reflect.StructField{
+			Name: goFieldName(sf.Name, i, goNames),
+			Type: t,
+			Tag:  tag,
+		})
+	}
+	return reflect.StructOf(structFields), nil
+}
+
+func schemaType(sf SchemaField, path string) (reflect.Type, error) {
+	if t, ok := schemaTypes[sf.Type]; ok {
+		return t, nil
+	}
+	switch sf.Type {
+	case "any":
+		return reflect.TypeOf((*any)(nil)).Elem(), nil
+	case "object":
+		if len(sf.Fields) == 0 {
+			return reflect.TypeOf(map[string]any{}), nil
+		}
+		return schemaStruct(sf.Fields, path)
+	case "list":
+		if sf.Items == nil {
+			return nil, schemaError(path, "a list needs items")
+		}
+		items := *sf.Items
+		items.Name = sf.Name
+		t, err := schemaType(items, path)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.SliceOf(t), nil
+	case "":
+		return nil, schemaError(path, "the type is missing")
+	}
+	return nil, schemaError(path, "unknown type '%s'", sf.Type)
+}
+
+// schemaTag turns the rules of sf into the struct tag they stand for.
+func schemaTag(sf SchemaField, path string) (reflect.StructTag, error) {
+	rules := make(map[string]string, len(sf.Rules)+2)
+	for name, value := range sf.Rules {
+		if !isSchemaRule(name) {
+			return "", schemaError(path, "unknown rule '%s'", name)
+		}
+		rules[name] = value
+	}
+	if sf.Required {
+		if binding, ok := rules["binding"]; ok && binding != "required" {
+			return "", schemaError(path, "required conflicts with binding '%s'", binding)
+		}
+		rules["binding"] = "required"
+	}
+	// each reaches through nested lists, so only the innermost items of a
+	// list of lists can take rules.
+	items := sf.Items
+	for items != nil && items.Type == "list" && items.Items != nil {
+		if len(items.Rules) > 0 {
+			return "", schemaError(path, "the items of a list of lists take rules on their innermost items")
+		}
+		items = items.Items
+	}
+	if items != nil && len(items.Rules) > 0 {
+		if items.Type == "object" {
+			return "", schemaError(path, "the items of a list of objects take rules on their fields")
+		}
+		if _, ok := rules["each"]; ok {
+			return "", schemaError(path, "each conflicts with the rules of the items")
+		}
+		each := make([]string, 0, len(items.Rules))
+		for _, name := range sortedKeys(items.Rules) {
+			if !isElementRule(name) {
+				return "", schemaError(path, "'%s' can't be applied to items", name)
+			}
+			each = append(each, name+"="+items.Rules[name])
+		}
+		rules["each"] = strings.Join(each, ",")
+	}
+
+	pairs := []string{"json:" + strconv.Quote(sf.Name)}
+	for _, name := range sortedKeys(rules) {
+		pairs = append(pairs, name+":"+strconv.Quote(rules[name]))
+	}
+	return reflect.StructTag(strings.Join(pairs, " ")), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// goFieldName derives an exported Go name from a JSON name, only shown in
+// configuration errors.
+func goFieldName(name string, i int, used map[string]bool) string {
+	out := []rune(name)
+	for j, r := range out {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			out[j] = '_'
+		}
+	}
+	out[0] = unicode.ToUpper(out[0])
+	goName := string(out)
+	if !unicode.IsUpper(out[0]) || used[goName] {
+		goName = "F" + strconv.Itoa(i) + "_" + goName
+	}
+	used[goName] = true
+	return goName
+}
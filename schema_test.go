@@ -0,0 +1,113 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const signupSchema = `{
+	"fields": [
+		{"name": "email", "type": "string", "required": true, "rules": {"format": "email"}},
+		{"name": "age", "type": "integer", "rules": {"ge": "18", "le": "130"}},
+		{"name": "kind", "type": "string", "required": true, "rules": {"enum": "person,company"}},
+		{"name": "company", "type": "string", "rules": {"when": "kind=company;binding=required"}},
+		{"name": "balance", "type": "decimal", "rules": {"decimal_places": "2"}},
+		{"name": "address", "type": "object", "fields": [
+			{"name": "city", "type": "string", "required": true, "rules": {"min": "2"}}
+		]},
+		{"name": "tags", "type": "list", "rules": {"unique": "true"}, "items": {"type": "string", "rules": {"max": "5"}}},
+		{"name": "contacts", "type": "list", "items": {"type": "object", "fields": [
+			{"name": "phone", "type": "string", "required": true}
+		]}},
+		{"name": "meta", "type": "object"},
+		{"name": "grid", "type": "list", "items": {"type": "list", "items": {"type": "integer", "rules": {"le": "9"}}}}
+	]
+}`
+
+func TestSchema(t *testing.T) {
+	g := &Validate{}
+	s, err := ParseSchema([]byte(signupSchema))
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		payload map[string]any
+		errType string
+		path    string
+	}{
+		{"Valid", map[string]any{
+			"email": "a@b.co", "age": 30, "kind": "company", "company": "Acme", "balance": "10.50",
+			"address":  map[string]any{"city": "Maputo"},
+			"tags":     []any{"go", "ml"},
+			"contacts": []any{map[string]any{"phone": "123"}},
+			"meta":     map[string]any{"anything": true},
+			"grid":     []any{[]any{1, 2}, []any{9}},
+		}, "", ""},
+		{"Required", map[string]any{"kind": "person"}, "REQUIRED_FIELD_ERR", "email"},
+		{"Format", map[string]any{"email": "nope", "kind": "person"}, "INVALID_EMAIL_ERR", "email"},
+		{"Range", map[string]any{"email": "a@b.co", "kind": "person", "age": 12}, "GREATER_EQUAL_ERR", "age"},
+		{"Enum", map[string]any{"email": "a@b.co", "kind": "robot"}, "INVALID_ENUM_ERR", "kind"},
+		{"When", map[string]any{"email": "a@b.co", "kind": "company"}, "REQUIRED_FIELD_ERR", "company"},
+		{"Decimal", map[string]any{"email": "a@b.co", "kind": "person", "balance": "1.234"}, "DECIMAL_PLACES_ERR", "balance"},
+		{"Nested", map[string]any{"email": "a@b.co", "kind": "person", "address": map[string]any{"city": "X"}}, "MIN_LENGTH_ERR", "address.city"},
+		{"Items", map[string]any{"email": "a@b.co", "kind": "person", "tags": []any{"golang"}}, "MAX_LENGTH_ERR", "tags[0]"},
+		{"Unique", map[string]any{"email": "a@b.co", "kind": "person", "tags": []any{"go", "go"}}, "DUPLICATE_ITEM_ERR", "tags[1]"},
+		{"List of lists", map[string]any{"email": "a@b.co", "kind": "person", "grid": []any{[]any{1}, []any{3, 10}}}, "LESS_EQUAL_ERR", "grid[1][1]"},
+		{"Type", map[string]any{"email": "a@b.co", "kind": "person", "age": "old"}, "TYPE_MISMATCH_ERR", "age"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.ValidateMap(s, tc.payload)
+			if tc.errType == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				e := err.(*Error)
+				assert.Equal(t, tc.errType, e.ErrType, e.Message)
+				assert.Equal(t, tc.path, e.Path)
+			}
+		})
+	}
+
+	t.Run("Same errors as structs", func(t *testing.T) {
+		type signup struct {
+			Email *string `json:"email" binding:"required" format:"email"`
+		}
+		schema := &Schema{Fields: []SchemaField{
+			{Name: "email", Type: "string", Required: true, Rules: map[string]string{"format": "email"}},
+		}}
+		payload := `{"email":"nope"}`
+		var v signup
+		assert.Equal(t, g.BindJSON([]byte(payload), &v), g.ValidateMap(schema, map[string]any{"email": "nope"}))
+	})
+
+	t.Run("Invalid schemas", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			fields  []SchemaField
+			errType string
+			path    string
+		}{
+			{"Unknown type", []SchemaField{{Name: "a", Type: "text"}}, "INVALID_SCHEMA_ERR", "a"},
+			{"Unknown rule", []SchemaField{{Name: "a", Type: "string", Rules: map[string]string{"mn": "1"}}}, "INVALID_SCHEMA_ERR", "a"},
+			{"List without items", []SchemaField{{Name: "a", Type: "list"}}, "INVALID_SCHEMA_ERR", "a"},
+			{"Rules on a nested list", []SchemaField{{Name: "a", Type: "list", Items: &SchemaField{
+				Type: "list", Rules: map[string]string{"min": "1"}, Items: &SchemaField{Type: "string"},
+			}}}, "INVALID_SCHEMA_ERR", "a"},
+			{"Duplicate name", []SchemaField{{Name: "a", Type: "string"}, {Name: "a", Type: "integer"}}, "INVALID_SCHEMA_ERR", "a"},
+			{"Nested", []SchemaField{{Name: "a", Type: "object", Fields: []SchemaField{{Name: "b"}}}}, "INVALID_SCHEMA_ERR", "a.b"},
+			{"Unknown format", []SchemaField{{Name: "a", Type: "string", Rules: map[string]string{"format": "nope"}}}, "INVALID_CONFIG_ERR", "a"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := g.ValidateMap(&Schema{Fields: tc.fields}, map[string]any{"a": "x"})
+				if assert.Error(t, err) {
+					assert.Equal(t, tc.errType, err.(*Error).ErrType, err.Error())
+					assert.Equal(t, tc.path, err.(*Error).Path)
+				}
+			})
+		}
+	})
+}
@@ -2,7 +2,6 @@ package godantic
 
 import (
 	"github.com/stretchr/testify/assert"
-	"regexp"
 	"testing"
 )
 
@@ -495,7 +494,7 @@ func TestFormatValidation(t *testing.T) {
 	})
 }
 
-func TestGetFormatRegex(t *testing.T) {
+func TestDefaultFormats(t *testing.T) {
 	testCases := []struct {
 		formatTag string
 		input     string
@@ -507,17 +506,20 @@ func TestGetFormatRegex(t *testing.T) {
 		{"url", "invalid-url", false},
 		{"date", "2024-02-29", true},
 		{"date", "invalid-date", false},
+		{"date", "2023-02-31", false},
 		{"time", "12:34:56", true},
 		{"time", "25:00:00", false},
 		{"uuid", "123e4567-e89b-12d3-a456-426614174000", true},
 		{"uuid", "invalid-uuid", false},
 		{"ip", "192.168.1.1", true},
 		{"ip", "invalid-ip", false},
-		{"credit_card", "1234-5678-9012-3456", true},
+		{"credit_card", "4111-1111-1111-1111", true},
+		{"credit_card", "1234-5678-9012-3456", false},
 		{"credit_card", "invalid-credit-card", false},
 		{"postal_code", "12345", true},
 		{"postal_code", "invalid-postal-code", false},
-		{"phone", "+1234567890", true},
+		{"phone", "+258841234567", true},
+		{"phone", "+1234567890", false},
 		{"phone", "1234567890", false},
 		{"ssn", "123-45-6789", true},
 		{"ssn", "invalid-ssn", false},
@@ -531,7 +533,8 @@ func TestGetFormatRegex(t *testing.T) {
 		{"hex_color", "invalid-hex-color", false},
 		{"mac_address", "00:0a:95:9d:68:16", true},
 		{"mac_address", "invalid-mac-address", false},
-		{"mz-msisdn", "258123456789", true},
+		{"mz-msisdn", "258841234567", true},
+		{"mz-msisdn", "258123456789", false},
 		{"mz-msisdn", "123456789", false},
 		{"mz-nuit", "123456789", true},
 		{"mz-nuit", "invalid-nuit", false},
@@ -541,8 +544,8 @@ func TestGetFormatRegex(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.formatTag, func(t *testing.T) {
-			regexPattern := getFormatRegex(tc.formatTag)
-			match := regexPattern != "" && regexp.MustCompile(regexPattern).MatchString(tc.input)
+			fn, ok := lookupFormat(tc.formatTag)
+			match := ok && fn(tc.input, nil)
 			if match != tc.expected {
 				t.Errorf("For format tag %s and input %s, expected match: %v, got: %v", tc.formatTag, tc.input, tc.expected, match)
 			}
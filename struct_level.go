@@ -0,0 +1,84 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// StructLevel is passed to struct validators. It reports errors on the
+// struct or its fields and gives access to the values around it.
+type StructLevel interface {
+	// Path returns the path of the struct being validated, "" at the root.
+	Path() string
+	// Parent returns the struct that holds this one, directly or in a
+	// list, or nil at the root.
+	Parent() any
+	// Root returns the value passed to InspectStruct or BindJSON.
+	Root() any
+	// ReportError reports an error on field, a path relative to the struct
+	// such as "end" or "items[0].sku", or on the struct itself if field is
+	// "". An empty message is replaced by a generic one.
+	ReportError(field, errType, message string)
+}
+
+type structValidatorFunc func(v any, sl StructLevel)
+
+// RegisterStructValidator runs fn on every struct of type T that is
+// validated, at the root, in fields and inside lists, replacing any
+// validator already registered for T. It lets types that can't implement
+// ValidationPlugin, such as third-party or generated ones, check rules
+// across their fields.
+func RegisterStructValidator[T any](fn func(v T, sl StructLevel)) {
+	RegisterStructValidatorIn[T](defaultRegistry, fn)
+}
+
+type structLevel struct {
+	path   string
+	parent any
+	root   any
+	err    *Error
+}
+
+func (sl *structLevel) Path() string { return sl.path }
+func (sl *structLevel) Parent() any  { return sl.parent }
+func (sl *structLevel) Root() any    { return sl.root }
+
+func (sl *structLevel) ReportError(field, errType, message string) {
+	if sl.err != nil {
+		// like the field checks, only the first error is reported
+		return
+	}
+	path := joinPath(sl.path, field)
+	if message == "" {
+		message = fmt.Sprintf("The field <%s> is invalid", path)
+	}
+	sl.err = &Error{ErrType: errType, Path: path, Message: message}
+}
+
+// joinPath appends field, a path relative to base such as "end" or
+// "items[0].sku", to base.
+func joinPath(base, field string) string {
+	switch {
+	case field == "":
+		return base
+	case base == "" || strings.HasPrefix(field, "["):
+		return base + field
+	default:
+		return base + "." + field
+	}
+}
+
+// validateStruct runs the struct validator registered for the type of v.
+func (g *Validate) validateStruct(v reflect.Value, path string) *Error {
+	fn, ok := g.registry().structValidator(v.Type())
+	if !ok {
+		return nil
+	}
+	sl := &structLevel{path: g.indexedPath(path), root: g.root}
+	if n := len(g.parents); n > 0 {
+		sl.parent = g.parents[n-1].Interface()
+	}
+	fn(v.Interface(), sl)
+	return sl.err
+}
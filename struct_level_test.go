@@ -0,0 +1,82 @@
+package godantic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type slPeriod struct {
+	Start *int `json:"start"`
+	End   *int `json:"end"`
+}
+
+type slBooking struct {
+	MaxNights *int        `json:"max_nights"`
+	Stay      slPeriod    `json:"stay"`
+	Extra     *slPeriod   `json:"extra"`
+	History   *[]slPeriod `json:"history"`
+	Nights    []slPeriod  `json:"nights"`
+}
+
+func TestStructValidators(t *testing.T) {
+	g := &Validate{}
+
+	var parents, roots []any
+	RegisterStructValidator(func(p slPeriod, sl StructLevel) {
+		parents = append(parents, sl.Parent())
+		roots = append(roots, sl.Root())
+		if p.Start == nil || p.End == nil {
+			return
+		}
+		if *p.End < *p.Start {
+			sl.ReportError("end", "PERIOD_ERR", "end must not be before start")
+		}
+		if b, ok := sl.Parent().(slBooking); ok && b.MaxNights != nil && *p.End-*p.Start > *b.MaxNights {
+			sl.ReportError("", "TOO_LONG_ERR", "")
+		}
+	})
+	defer func() {
+		defaultRegistry.mu.Lock()
+		delete(defaultRegistry.structs, reflect.TypeOf(slPeriod{}))
+		defaultRegistry.mu.Unlock()
+	}()
+
+	booking := slBooking{
+		MaxNights: toPtr(7),
+		Stay:      slPeriod{Start: toPtr(1), End: toPtr(3)},
+		Extra:     &slPeriod{Start: toPtr(4), End: toPtr(5)},
+		History:   &[]slPeriod{{Start: toPtr(1), End: toPtr(2)}},
+	}
+	assert.NoError(t, g.InspectStruct(booking))
+
+	t.Run("Parent and root", func(t *testing.T) {
+		parents, roots = nil, nil
+		assert.NoError(t, g.InspectStruct(slPeriod{Start: toPtr(1), End: toPtr(2)}))
+		assert.Equal(t, []any{nil}, parents)
+		assert.Equal(t, []any{slPeriod{Start: toPtr(1), End: toPtr(2)}}, roots)
+	})
+
+	testCases := []struct {
+		name    string
+		value   any
+		errType string
+		path    string
+	}{
+		{"At the root", slPeriod{Start: toPtr(5), End: toPtr(1)}, "PERIOD_ERR", "end"},
+		{"In a struct field", slBooking{Stay: slPeriod{Start: toPtr(5), End: toPtr(1)}}, "PERIOD_ERR", "stay.end"},
+		{"In a pointer field", slBooking{Extra: &slPeriod{Start: toPtr(5), End: toPtr(1)}}, "PERIOD_ERR", "extra.end"},
+		{"Inside a list", &slBooking{History: &[]slPeriod{{Start: toPtr(1), End: toPtr(2)}, {Start: toPtr(5), End: toPtr(1)}}}, "PERIOD_ERR", "history[1].end"},
+		{"Inside a list held by value", slBooking{Nights: []slPeriod{{Start: toPtr(1), End: toPtr(2)}, {Start: toPtr(5), End: toPtr(1)}}}, "PERIOD_ERR", "nights[1].end"},
+		{"Using the parent", slBooking{MaxNights: toPtr(2), Stay: slPeriod{Start: toPtr(1), End: toPtr(10)}}, "TOO_LONG_ERR", "stay"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.value)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+}
@@ -4,7 +4,6 @@ package godantic
 import (
 	"fmt"
 	"reflect"
-	"regexp"
 	"strings"
 	"time"
 )
@@ -33,8 +32,15 @@ func isList(value reflect.Value) bool {
 var TimeType = reflect.TypeOf(time.Time{})
 
 func (g *Validate) InspectStruct(val interface{}) error {
+	return g.publicError(g.inspectStruct(val))
+}
+
+func (g *Validate) inspectStruct(val interface{}) error {
 	enumMap := extractEnumValues(getValueOf(val), "")
-	return g.inspect(val, "", 0, reflect.StructField{}, enumMap)
+	// per-call state lives on a copy, so a Validate can be shared
+	sg := *g
+	sg.root, sg.parents, sg.indices = val, nil, nil
+	return sg.inspect(val, "", 0, reflect.StructField{}, enumMap)
 }
 
 func (g *Validate) inspect(val interface{}, tree string, i int, f reflect.StructField, enumMap map[string]string) error {
@@ -47,6 +53,9 @@ func (g *Validate) inspect(val interface{}, tree string, i int, f reflect.Struct
 	switch {
 	case isPtr(v):
 		return g.inspect(v.Elem().Interface(), tree, i, f, enumMap)
+	case isValue(v):
+		// value types are parsed in bindJSON, their bounds are checked in checkField
+		return nil
 	case isStruct(v):
 		return g.checkStruct(val, v, tree, enumMap)
 	case isString(v):
@@ -54,97 +63,13 @@ func (g *Validate) inspect(val interface{}, tree string, i int, f reflect.Struct
 	case isTime(v):
 		return g.checkTime(v, tree)
 	case isList(v):
-		return g.checkList(v, tree, enumMap)
+		return g.checkList(v, tree, f, enumMap)
 	default:
 		return nil
 
 	}
 }
 
-func getFormatRegex(formatTag string) string {
-	switch formatTag {
-	case "email":
-		return `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
-	case "url":
-		return `^(http|https)://[a-zA-Z0-9./?=_%-&]+$`
-	case "date":
-		return `^\d{4}-\d{2}-\d{2}$`
-	case "time":
-		return `^([01]\d|2[0-3]):([0-5]\d):([0-5]\d)$`
-	case "uuid":
-		return `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
-	case "ip":
-		return `^(\d{1,3}\.){3}\d{1,3}$`
-	case "credit_card":
-		return `^\d{4}-\d{4}-\d{4}-\d{4}$`
-	case "postal_code":
-		return `^[a-zA-Z0-9]+$`
-	case "phone":
-		return `^\+[1-9]\d{1,14}$`
-	case "ssn":
-		return `^\d{3}-\d{2}-\d{4}$`
-	case "credit_card_expiry":
-		return `^(0[1-9]|1[0-2])\/(20\d{2}|2[1-9]\d{1})$`
-	case "latitude":
-		return `^(-?([0-8]?[0-9]\.\d+|90(\.0+)?))$`
-	case "longitude":
-		return `^(-?((1?[0-7]?|[0-9]?)[0-9]\.\d+|180(\.0+)?))$`
-	case "hex_color":
-		return `^#?([a-fA-F0-9]{6}|[a-fA-F0-9]{3})$`
-	case "mac_address":
-		return `^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$`
-	case "mz-msisdn":
-		return `^258\d{9}$`
-	case "mz-nuit":
-		return `^\d{9}$`
-	case "mz-bi":
-		return `^\d{12}[A-Z]$`
-	default:
-		return ""
-	}
-}
-
-func (g *Validate) formatValidation(f reflect.StructField, v reflect.Value, tree string) error {
-	tag := f.Tag.Get("format")
-	if tag == "" {
-		return nil
-	}
-	if v.Kind() == reflect.Ptr {
-		if v.IsNil() {
-			return nil
-		}
-		v = v.Elem()
-	}
-	fieldValue := v.String()
-	formatRegex := getFormatRegex(tag)
-	if matchRegexPattern(formatRegex, fieldValue, f, tree) != nil {
-		return &Error{
-			ErrType: fmt.Sprintf("INVALID_%s_ERR", strings.ToUpper(tag)),
-			Path:    fieldName(f, tree),
-			Message: fmt.Sprintf("error on field <%s>. the given value '%s' is not a valid %s", fieldName(f, tree), fieldValue, tag),
-		}
-	}
-
-	return matchRegexPattern(formatRegex, fieldValue, f, tree)
-}
-
-func matchRegexPattern(regexpPattern, fieldValue string, f reflect.StructField, tree string) error {
-	// Compile the regular expression pattern
-	regexpPatternCompiled, err := regexp.Compile(regexpPattern)
-	if err != nil {
-		return err // Handle error
-	}
-	// Check if the field's value matches the regular expression pattern
-	if !regexpPatternCompiled.MatchString(fieldValue) {
-		return &Error{
-			ErrType: "INVALID_PATTERN_ERR",
-			Path:    fieldName(f, tree),
-			Message: fmt.Sprintf("The field <%s> value '%s' does not match the required pattern: %s", fieldName(f, tree), fieldValue, regexpPattern),
-		}
-	}
-	return nil
-}
-
 func (g *Validate) regexPattern(f reflect.StructField, v reflect.Value, tree string) error {
 	regexpPattern := f.Tag.Get("regex")
 	if regexpPattern == "" {
@@ -158,7 +83,6 @@ func (g *Validate) regexPattern(f reflect.StructField, v reflect.Value, tree str
 	}
 	fieldValue := v.String()
 
-	// Compile the regular expression pattern
 	return matchRegexPattern(regexpPattern, fieldValue, f, tree)
 
 }
@@ -191,7 +115,7 @@ func (g *Validate) checkString(v reflect.Value, tree string, _ int, f reflect.St
 	return nil
 }
 
-func (g *Validate) checkList(v reflect.Value, tree string, enumMap map[string]string) error {
+func (g *Validate) checkList(v reflect.Value, tree string, f reflect.StructField, enumMap map[string]string) error {
 	min := 1
 	if g.IgnoreMinLen == true {
 		min = 0
@@ -205,56 +129,110 @@ func (g *Validate) checkList(v reflect.Value, tree string, enumMap map[string]st
 			Message: fmt.Sprintf("Field <%s> must be with at least one value.", tree),
 		}
 	}
+	var errs Errors
+	if err := g.checkListItems(v, tree, enumMap); err != nil && !g.collect(&errs, err) {
+		return err
+	}
+	if err := g.checkUnique(f, v, tree); err != nil && !g.collect(&errs, err) {
+		return err
+	}
+	return errs.result()
+}
+
+func (g *Validate) checkListItems(v reflect.Value, tree string, enumMap map[string]string) error {
+	var errs Errors
 	for i := 0; i < v.Len(); i++ {
-		elem := v.Index(i)
-		err := g.inspect(elem.Interface(), tree, i, reflect.StructField{}, enumMap)
-		if err != nil {
+		if err := g.checkListItem(v.Index(i), tree, i, enumMap); err != nil && !g.collect(&errs, err) {
 			return err
 		}
-		if cv, ok := resolveInterface[ValidationPlugin](elem); ok {
-			if err := cv.Validate(); err != nil {
-				return &Error{
-					ErrType: err.ErrType,
-					Message: err.Message,
-					Path:    err.Path,
-					err:     err,
-				}
+	}
+	return errs.result()
+}
+
+// hasCheckedItems reports whether the items of list type t are checked on
+// their own: structs, whose fields are checked one by one, and values with
+// a plugin.
+func hasCheckedItems(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return false
+	}
+	elem := t.Elem()
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.Struct && !isValueType(elem) && !elem.ConvertibleTo(TimeType) {
+		return true
+	}
+	ptr := reflect.PtrTo(elem)
+	return ptr.Implements(pluginV2Type) || ptr.Implements(pluginType)
+}
+
+func (g *Validate) checkListItem(elem reflect.Value, tree string, i int, enumMap map[string]string) error {
+	if elem.Kind() == reflect.Ptr && elem.IsNil() {
+		return nil
+	}
+	g.indices = append(g.indices, pathIndex{plain: tree, indexed: fmt.Sprintf("%s[%d]", g.indexedPath(tree), i)})
+	defer func() { g.indices = g.indices[:len(g.indices)-1] }()
+
+	err := g.inspect(elem.Interface(), tree, i, reflect.StructField{}, enumMap)
+	if err != nil {
+		return err
+	}
+	if cv, ok := resolveInterface[ValidationPlugin](elem); ok {
+		if err := cv.Validate(); err != nil {
+			return &Error{
+				ErrType: err.ErrType,
+				Message: err.Message,
+				Path:    err.Path,
+				err:     err,
 			}
 		}
-		if df, ok := resolveInterface[DynamicFieldsValidator](elem); ok {
-			if err := validateDynamicFields(df.GetValue(), df.GetAttribute(), df.GetValueType(), tree); err != nil {
-				return err
-			}
+	}
+	if df, ok := resolveInterface[DynamicFieldsValidator](elem); ok {
+		if err := validateDynamicFields(df.GetValue(), df.GetAttribute(), df.GetValueType(), tree); err != nil {
+			return err
 		}
 	}
-
+	if !isInspectedStruct(elem) {
+		return g.validatePluginV2(elem, tree)
+	}
 	return nil
 }
 
 func (g *Validate) checkStruct(val interface{}, v reflect.Value, tree string, enumMao map[string]string) error {
 	t := v.Type()
-	if err := g.validateInterfaceHooks(val, tree); err != nil {
+	if issue := g.typeConfigIssue(t); issue != nil {
+		return issue.error(tree)
+	}
+	if err := g.validateInterfaceHooks(val, v, tree); err != nil {
+		return err
+	}
+	var errs Errors
+	if err := g.validatePluginV2(v, tree); err != nil && !g.collect(&errs, err) {
 		return err
 	}
+	g.parents = append(g.parents, v)
+	defer func() { g.parents = g.parents[:len(g.parents)-1] }()
 
 	for i := 0; i < t.NumField(); i++ {
+		var err error
 		if isTime(v.Field(i)) {
-			// ignore time.Time fields, they are already checked in bindJSON
-			continue
+			// time.Time values are parsed in bindJSON, only their constraints apply
+			err = g.checkTimeConstraints(structField(t, i), v.Field(i), tree)
+		} else {
+			err = g.checkField(val, v, t, tree, i, enumMao)
 		}
-
-		err := g.checkField(val, v, t, tree, i, enumMao)
-		if err != nil {
+		if err != nil && !g.collect(&errs, err) {
 			return err
 		}
 	}
 
-	return nil
+	return errs.result()
 }
 
 func (g *Validate) checkField(val interface{}, v reflect.Value, t reflect.Type, tree string, i int, enumMap map[string]string) error {
 
-	f := t.Field(i)
+	f := structField(t, i)
 	if f.PkgPath != "" {
 		// Field is unexported, handle it gracefully
 		return nil
@@ -282,18 +260,30 @@ func (g *Validate) checkField(val interface{}, v reflect.Value, t reflect.Type,
 		if err := g.inspect(valField.Interface(), fieldName(f, tree), i, f, enumMap); err != nil {
 			return err
 		}
-	case f.Type.Kind() == reflect.Struct:
+	case f.Type.Kind() == reflect.Struct && !isValueType(f.Type):
 		// Handle non-pointer struct fields
 		if err := g.checkStruct(val, valField, fieldName(f, tree), enumMap); err != nil {
 			return err
 		}
 	case f.Type.Kind() != reflect.Ptr:
-		if !g.IgnoreRequired && isFieldRequired(f) && reflect.DeepEqual(valField.Interface(), reflect.Zero(f.Type).Interface()) {
+		if !g.IgnoreRequired && isFieldRequired(f) && g.isMissing(f, valField, tree) {
 			return RequiredFieldError(f, tree)
 		}
+		if hasCheckedItems(f.Type) {
+			// the items of a list held by value get the same checks as
+			// those of a list behind a pointer
+			if err := g.checkListItems(valField, fieldName(f, tree), enumMap); err != nil {
+				return err
+			}
+		}
+		if isList(valField) {
+			if err := g.checkUnique(f, valField, fieldName(f, tree)); err != nil {
+				return err
+			}
+		}
 	case !g.IgnoreRequired:
 		if isFieldRequired(f) {
-			if f.Type.Kind() == reflect.Ptr && valField.IsNil() {
+			if f.Type.Kind() == reflect.Ptr && g.isMissing(f, valField, tree) {
 				return RequiredFieldError(f, tree)
 			}
 
@@ -303,7 +293,7 @@ func (g *Validate) checkField(val interface{}, v reflect.Value, t reflect.Type,
 		if !v.IsValid() || v.IsNil() {
 			return nil // nil pointer is valid
 		}
-		return g.inspect(v.Elem().Interface(), tree, i, v.Type().Field(i), enumMap)
+		return g.inspect(v.Elem().Interface(), tree, i, structField(v.Type(), i), enumMap)
 
 	}
 	if err := g.validateCondition(f, valField, tree, enumMap); err != nil {
@@ -318,6 +308,15 @@ func (g *Validate) checkField(val interface{}, v reflect.Value, t reflect.Type,
 	if err := g.checkDecimalConstraints(f, valField, tree); err != nil {
 		return err
 	}
+	if err := g.checkTimeConstraints(f, valField, tree); err != nil {
+		return err
+	}
+	if err := g.checkValueBounds(f, valField, tree); err != nil {
+		return err
+	}
+	if err := g.checkMoney(f, valField, tree); err != nil {
+		return err
+	}
 
 	if err := g.regexPattern(f, valField, tree); err != nil {
 		return err
@@ -338,6 +337,9 @@ func (g *Validate) checkField(val interface{}, v reflect.Value, t reflect.Type,
 			return err
 		}
 	}
+	if err := g.checkElements(f, valField, tree); err != nil {
+		return err
+	}
 	if cv, ok := resolveInterface[ValidationPlugin](valField); ok {
 		if err := cv.Validate(); err != nil {
 			return &Error{
@@ -354,6 +356,9 @@ func (g *Validate) checkField(val interface{}, v reflect.Value, t reflect.Type,
 			return err
 		}
 	}
+	if !isInspectedStruct(valField) {
+		return g.validatePluginV2(valField, fieldName(f, tree))
+	}
 
 	return nil
 }
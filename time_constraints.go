@@ -0,0 +1,192 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeNow is the clock the time constraints are checked against.
+var timeNow = time.Now
+
+// timeOffset is a span of time given in a tag, e.g. "18y" or "1d12h".
+// Years, months and days are calendar units and are applied with AddDate.
+type timeOffset struct {
+	years, months, days int
+	duration            time.Duration
+}
+
+var calendarUnit = regexp.MustCompile(`^(\d+)(y|mo|w|d)`)
+
+// parseTimeOffset reads a sequence of calendar units (y, mo, w, d) followed
+// by an optional time.ParseDuration string, e.g. "1y6mo" or "2d12h".
+func parseTimeOffset(s string) (timeOffset, error) {
+	var o timeOffset
+	rest := s
+	for {
+		m := calendarUnit.FindStringSubmatch(rest)
+		if m == nil {
+			break
+		}
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return o, fmt.Errorf("invalid duration '%s'", s)
+		}
+		switch m[2] {
+		case "y":
+			o.years += n
+		case "mo":
+			o.months += n
+		case "w":
+			o.days += 7 * n
+		case "d":
+			o.days += n
+		}
+		rest = rest[len(m[0]):]
+	}
+	if rest != "" || s == "" {
+		d, err := time.ParseDuration(rest)
+		if err != nil {
+			return o, fmt.Errorf("invalid duration '%s'", s)
+		}
+		o.duration = d
+	}
+	return o, nil
+}
+
+func (o timeOffset) addTo(t time.Time, sign int) time.Time {
+	return t.AddDate(sign*o.years, sign*o.months, sign*o.days).Add(time.Duration(sign) * o.duration)
+}
+
+// timeBoundLayouts are the layouts accepted for absolute `after` and
+// `before` bounds.
+var timeBoundLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}
+
+// parseTimeBound reads an `after` or `before` tag: either an absolute time
+// or "now" with an optional offset, e.g. "now+24h" or "now-1y".
+func parseTimeBound(s string, now time.Time) (time.Time, error) {
+	if strings.HasPrefix(s, "now") {
+		rest := s[len("now"):]
+		if rest == "" {
+			return now, nil
+		}
+		sign := 1
+		switch rest[0] {
+		case '+':
+		case '-':
+			sign = -1
+		default:
+			return time.Time{}, fmt.Errorf("invalid time bound '%s'", s)
+		}
+		o, err := parseTimeOffset(rest[1:])
+		if err != nil {
+			return time.Time{}, err
+		}
+		return o.addTo(now, sign), nil
+	}
+	for _, layout := range timeBoundLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time bound '%s'", s)
+}
+
+// checkTimeConfig reports time constraints that can never be evaluated.
+func checkTimeConfig(f reflect.StructField) string {
+	now := time.Time{}
+	for _, tag := range []string{"after", "before"} {
+		if bound, ok := f.Tag.Lookup(tag); ok {
+			if _, err := parseTimeBound(bound, now); err != nil {
+				return fmt.Sprintf("%s: %v", tag, err)
+			}
+		}
+	}
+	for _, tag := range []string{"max_age", "min_age"} {
+		if age, ok := f.Tag.Lookup(tag); ok {
+			if _, err := parseTimeOffset(age); err != nil {
+				return fmt.Sprintf("%s: %v", tag, err)
+			}
+		}
+	}
+	switch rule := f.Tag.Get("time"); rule {
+	case "", "past", "future":
+	default:
+		return fmt.Sprintf("unknown time rule '%s', expected past or future", rule)
+	}
+	return ""
+}
+
+func (g *Validate) checkTimeConstraints(f reflect.StructField, v reflect.Value, tree string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.Type().ConvertibleTo(TimeType) {
+		return nil
+	}
+	value := v.Convert(TimeType).Interface().(time.Time)
+	now := timeNow()
+	path := fieldName(f, tree)
+
+	switch f.Tag.Get("time") {
+	case "past":
+		if !value.Before(now) {
+			return &Error{
+				ErrType: "TIME_PAST_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be in the past", path),
+			}
+		}
+	case "future":
+		if !value.After(now) {
+			return &Error{
+				ErrType: "TIME_FUTURE_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be in the future", path),
+			}
+		}
+	}
+	if tag := f.Tag.Get("after"); tag != "" {
+		if bound, err := parseTimeBound(tag, now); err == nil && !value.After(bound) {
+			return &Error{
+				ErrType: "TIME_AFTER_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be after %s", path, bound.Format(time.RFC3339)),
+			}
+		}
+	}
+	if tag := f.Tag.Get("before"); tag != "" {
+		if bound, err := parseTimeBound(tag, now); err == nil && !value.Before(bound) {
+			return &Error{
+				ErrType: "TIME_BEFORE_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be before %s", path, bound.Format(time.RFC3339)),
+			}
+		}
+	}
+	if tag := f.Tag.Get("max_age"); tag != "" {
+		if age, err := parseTimeOffset(tag); err == nil && value.Before(age.addTo(now, -1)) {
+			return &Error{
+				ErrType: "MAX_AGE_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be at most %s ago", path, tag),
+			}
+		}
+	}
+	if tag := f.Tag.Get("min_age"); tag != "" {
+		if age, err := parseTimeOffset(tag); err == nil && value.After(age.addTo(now, -1)) {
+			return &Error{
+				ErrType: "MIN_AGE_ERR",
+				Path:    path,
+				Message: fmt.Sprintf("The field <%s> must be at least %s ago", path, tag),
+			}
+		}
+	}
+	return nil
+}
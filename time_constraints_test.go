@@ -0,0 +1,121 @@
+package godantic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fixClock(t *testing.T, now time.Time) {
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = time.Now })
+}
+
+func TestParseTimeOffset(t *testing.T) {
+	base := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"18y", time.Date(2042, 1, 31, 12, 0, 0, 0, time.UTC)},
+		{"1y6mo", time.Date(2025, 7, 31, 12, 0, 0, 0, time.UTC)},
+		{"2w", time.Date(2024, 2, 14, 12, 0, 0, 0, time.UTC)},
+		{"1d12h", time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)},
+		{"30m", time.Date(2024, 1, 31, 12, 30, 0, 0, time.UTC)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			o, err := parseTimeOffset(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, o.addTo(base, 1))
+		})
+	}
+
+	for _, input := range []string{"", "18", "y", "1x", "1y-"} {
+		_, err := parseTimeOffset(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestTimeConstraints(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	fixClock(t, now)
+	g := &Validate{}
+
+	type Booking struct {
+		BirthDate *time.Time `json:"birth_date" time:"past" min_age:"18y" max_age:"120y"`
+		CheckIn   *time.Time `json:"check_in" time:"future" before:"now+30d"`
+		Issued    time.Time  `json:"issued" after:"2000-01-01"`
+	}
+	valid := func() Booking {
+		return Booking{
+			BirthDate: toPtr(time.Date(1990, 3, 1, 0, 0, 0, 0, time.UTC)),
+			CheckIn:   toPtr(now.Add(48 * time.Hour)),
+			Issued:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	assert.NoError(t, g.InspectStruct(valid()))
+
+	testCases := []struct {
+		name    string
+		mutate  func(b *Booking)
+		errType string
+		path    string
+	}{
+		{"Birth date in the future", func(b *Booking) { b.BirthDate = toPtr(now.Add(time.Hour)) }, "TIME_PAST_ERR", "birth_date"},
+		{"Too young", func(b *Booking) { b.BirthDate = toPtr(time.Date(2006, 6, 16, 0, 0, 0, 0, time.UTC)) }, "MIN_AGE_ERR", "birth_date"},
+		{"Too old", func(b *Booking) { b.BirthDate = toPtr(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)) }, "MAX_AGE_ERR", "birth_date"},
+		{"Check-in in the past", func(b *Booking) { b.CheckIn = toPtr(now.Add(-time.Minute)) }, "TIME_FUTURE_ERR", "check_in"},
+		{"Check-in too far ahead", func(b *Booking) { b.CheckIn = toPtr(now.AddDate(0, 2, 0)) }, "TIME_BEFORE_ERR", "check_in"},
+		{"Issued too early", func(b *Booking) { b.Issued = time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC) }, "TIME_AFTER_ERR", "issued"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := valid()
+			tc.mutate(&b)
+			err := g.InspectStruct(b)
+			assert.Error(t, err)
+			assert.Equal(t, tc.errType, err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+
+	t.Run("Exactly 18 years old", func(t *testing.T) {
+		b := valid()
+		b.BirthDate = toPtr(time.Date(2006, 6, 15, 12, 0, 0, 0, time.UTC))
+		assert.NoError(t, g.InspectStruct(b))
+	})
+
+	t.Run("Nil pointers are skipped", func(t *testing.T) {
+		b := valid()
+		b.BirthDate, b.CheckIn = nil, nil
+		assert.NoError(t, g.InspectStruct(b))
+	})
+}
+
+func TestTimeConstraintConfig(t *testing.T) {
+	g := &Validate{}
+	testCases := []struct {
+		name  string
+		value any
+	}{
+		{"Unknown rule", struct {
+			At *time.Time `json:"at" time:"yesterday"`
+		}{}},
+		{"Malformed bound", struct {
+			At *time.Time `json:"at" after:"now*2"`
+		}{}},
+		{"Malformed age", struct {
+			At *time.Time `json:"at" max_age:"eighteen"`
+		}{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.value)
+			assert.Error(t, err)
+			assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+			assert.Equal(t, "at", err.(*Error).Path)
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Special `time_format` values for Unix epoch timestamps, given as JSON
+// numbers or numeric strings.
+const (
+	TimeFormatUnix      = "unix"
+	TimeFormatUnixMilli = "unix_ms"
+)
+
+// timeFieldTypes caches whether a type has a time.Time or value type field
+// anywhere in it.
+var timeFieldTypes sync.Map
+
+func hasTimeFields(t reflect.Type) bool {
+	if cached, ok := timeFieldTypes.Load(t); ok {
+		return cached.(bool)
+	}
+	has := containsTime(t, make(map[reflect.Type]bool))
+	timeFieldTypes.Store(t, has)
+	return has
+}
+
+// isTimeType reports whether t, or the type it points to, is a time.
+func isTimeType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.ConvertibleTo(TimeType)
+}
+
+func containsTime(t reflect.Type, seen map[reflect.Type]bool) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.ConvertibleTo(TimeType) || isValueType(t) {
+		return true
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return false
+	}
+	seen[t] = true
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.PkgPath == "" && containsTime(f.Type, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// bindTimes checks the time and value type fields of obj in the JSON
+// payload before it is decoded, so parse failures are reported with the
+// field path. Fields with a `time_format` tag are rewritten to RFC 3339,
+// which is what time.Time decodes.
+func bindTimes(jsonData []byte, obj any) ([]byte, error) {
+	t := reflect.TypeOf(obj)
+	if t == nil || !hasTimeFields(t) {
+		return jsonData, nil
+	}
+	var data map[string]any
+	if err := unmarshalJSON(jsonData, &data); err != nil {
+		// Leave malformed payloads to decodeJSON, which reports them.
+		return jsonData, nil
+	}
+	changed, err := bindStructTimes(data, t, "")
+	if err != nil || !changed {
+		return jsonData, err
+	}
+	return json.Marshal(data)
+}
+
+func bindStructTimes(data map[string]any, t reflect.Type, tree string) (bool, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.ConvertibleTo(TimeType) || isValueType(t) {
+		return false, nil
+	}
+	changed := false
+	for i := 0; i < t.NumField(); i++ {
+		f := structField(t, i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" && f.Anonymous {
+			// Fields of embedded structs are promoted to this level.
+			c, err := bindStructTimes(data, f.Type, tree)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		raw, ok := data[name]
+		if !ok || raw == nil {
+			continue
+		}
+		path := name
+		if tree != "" {
+			path = tree + "." + name
+		}
+		c, err := bindTimeValue(f, f.Type, raw, path, func(v any) { data[name] = v })
+		if err != nil {
+			return false, err
+		}
+		changed = changed || c
+	}
+	return changed, nil
+}
+
+func bindTimeValue(f reflect.StructField, t reflect.Type, raw any, path string, set func(any)) (bool, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case isValueType(t):
+		if !validValue(t, raw) {
+			return false, invalidValueError(t, raw, path)
+		}
+		return false, nil
+	case t.ConvertibleTo(TimeType):
+		layout := f.Tag.Get("time_format")
+		value, err := parseJSONTime(raw, layout)
+		if err != nil {
+			return false, invalidTimeError(raw, layout, path)
+		}
+		if layout == "" || value.IsZero() {
+			return false, nil
+		}
+		set(value.Format(time.RFC3339Nano))
+		return true, nil
+	case t.Kind() == reflect.Struct:
+		if m, ok := raw.(map[string]any); ok {
+			return bindStructTimes(m, t, path)
+		}
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		list, ok := raw.([]any)
+		if !ok {
+			return false, nil
+		}
+		changed := false
+		for i := range list {
+			i := i
+			c, err := bindTimeValue(f, t.Elem(), list[i], fmt.Sprintf("%s[%d]", path, i), func(v any) { list[i] = v })
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+		return changed, nil
+	case t.Kind() == reflect.Map:
+		m, ok := raw.(map[string]any)
+		if !ok {
+			return false, nil
+		}
+		// Sorted, so the first invalid value reported doesn't vary.
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		changed := false
+		for _, key := range keys {
+			key := key
+			c, err := bindTimeValue(f, t.Elem(), m[key], fmt.Sprintf("%s[%s]", path, key), func(v any) { m[key] = v })
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+		return changed, nil
+	}
+	return false, nil
+}
+
+// parseJSONTime parses a decoded JSON value with a `time_format` layout. An
+// empty layout means RFC 3339, as for time.Time itself. Values of another
+// JSON type return the zero time and are left to the decoder to report.
+func parseJSONTime(raw any, layout string) (time.Time, error) {
+	switch layout {
+	case TimeFormatUnix, TimeFormatUnixMilli:
+		var s string
+		switch v := raw.(type) {
+		case json.Number:
+			s = v.String()
+		case string:
+			s = v
+		default:
+			return time.Time{}, nil
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if layout == TimeFormatUnix {
+			return time.Unix(n, 0).UTC(), nil
+		}
+		return time.UnixMilli(n).UTC(), nil
+	}
+
+	s, ok := raw.(string)
+	if !ok {
+		return time.Time{}, nil
+	}
+	if layout == "" {
+		return time.Parse(time.RFC3339, s)
+	}
+	value, err := time.Parse(layout, s)
+	if err != nil {
+		// Accept RFC 3339 as well, so a marshalled struct binds back.
+		if rfc, rfcErr := time.Parse(time.RFC3339, s); rfcErr == nil {
+			return rfc, nil
+		}
+	}
+	return value, err
+}
+
+func invalidTimeError(raw any, layout, path string) error {
+	var expected string
+	switch layout {
+	case "":
+		expected = fmt.Sprintf("format `%s`", time.RFC3339)
+	case TimeFormatUnix:
+		expected = "Unix seconds"
+	case TimeFormatUnixMilli:
+		expected = "Unix milliseconds"
+	default:
+		expected = fmt.Sprintf("format `%s`", layout)
+	}
+	return &Error{
+		ErrType: "INVALID_TIME_ERR",
+		Path:    path,
+		Message: fmt.Sprintf("The field <%s> has an invalid time '%v', expected %s", path, raw, expected),
+	}
+}
@@ -0,0 +1,95 @@
+package godantic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeFormatBinding(t *testing.T) {
+	g := &Validate{}
+
+	type Shipment struct {
+		ID         *string              `json:"id"`
+		ShippedOn  *time.Time           `json:"shipped_on" time_format:"02/01/2006"`
+		CreatedAt  *time.Time           `json:"created_at" time_format:"unix"`
+		UpdatedAt  *time.Time           `json:"updated_at" time_format:"unix_ms"`
+		Deliveries []time.Time          `json:"deliveries" time_format:"2006-01-02"`
+		Received   *time.Time           `json:"received"`
+		Carrier    shipCarrier          `json:"carrier"`
+		Stops      map[string]time.Time `json:"stops" time_format:"2006-01-02"`
+		Seen       map[string]time.Time `json:"seen"`
+	}
+
+	t.Run("Custom layouts and epochs", func(t *testing.T) {
+		var s Shipment
+		err := g.BindJSON([]byte(`{
+			"id": "S-1",
+			"shipped_on": "25/12/2023",
+			"created_at": 1700000000,
+			"updated_at": "1700000000123",
+			"deliveries": ["2024-01-02", "2024-01-05"],
+			"received": "2024-01-06T10:00:00Z",
+			"carrier": {"name": "DHL", "since": "03/2011"}
+		}`), &s)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC), s.ShippedOn.UTC())
+		assert.Equal(t, time.Unix(1700000000, 0).UTC(), s.CreatedAt.UTC())
+		assert.Equal(t, time.UnixMilli(1700000000123).UTC(), s.UpdatedAt.UTC())
+		assert.Equal(t, []time.Time{
+			time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		}, s.Deliveries)
+		assert.Equal(t, time.Date(2011, 3, 1, 0, 0, 0, 0, time.UTC), s.Carrier.Since.UTC())
+	})
+
+	t.Run("Map values", func(t *testing.T) {
+		var s Shipment
+		err := g.BindJSON([]byte(`{"id": "S-1", "stops": {"maputo": "2024-01-02"}}`), &s)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), s.Stops["maputo"].UTC())
+	})
+
+	t.Run("RFC 3339 is accepted with a layout", func(t *testing.T) {
+		var s Shipment
+		err := g.BindJSON([]byte(`{"id": "S-1", "shipped_on": "2023-12-25T00:00:00Z"}`), &s)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC), s.ShippedOn.UTC())
+	})
+
+	t.Run("JSON Patch on a formatted field", func(t *testing.T) {
+		s := Shipment{ID: toPtr("S-1"), ShippedOn: toPtr(time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC))}
+		err := g.BindJSONPatch([]byte(`[{"op": "replace", "path": "/shipped_on", "value": "26/12/2023"}]`), &s)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 12, 26, 0, 0, 0, 0, time.UTC), s.ShippedOn.UTC())
+	})
+
+	testCases := []struct {
+		name string
+		data string
+		path string
+	}{
+		{"Wrong layout", `{"id": "S-1", "shipped_on": "2023/12/25"}`, "shipped_on"},
+		{"Non-numeric epoch", `{"id": "S-1", "created_at": "yesterday"}`, "created_at"},
+		{"Invalid list element", `{"id": "S-1", "deliveries": ["2024-01-02", "02/01/2024"]}`, "deliveries[1]"},
+		{"Invalid RFC 3339", `{"id": "S-1", "received": "2024-01-06"}`, "received"},
+		{"Nested field", `{"id": "S-1", "carrier": {"name": "DHL", "since": "2011-03"}}`, "carrier.since"},
+		{"Map value", `{"id": "S-1", "stops": {"maputo": "2024-01-02", "beira": "02/01/2024"}}`, "stops[beira]"},
+		{"Map value without a layout", `{"id": "S-1", "seen": {"beira": "2024-01-02"}}`, "seen[beira]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var s Shipment
+			err := g.BindJSON([]byte(tc.data), &s)
+			assert.Error(t, err)
+			assert.Equal(t, "INVALID_TIME_ERR", err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+		})
+	}
+}
+
+type shipCarrier struct {
+	Name  *string    `json:"name"`
+	Since *time.Time `json:"since" time_format:"01/2006"`
+}
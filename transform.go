@@ -0,0 +1,116 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TransformFunc rewrites a string value before it is validated, e.g. to
+// trim or lowercase it.
+type TransformFunc func(string) string
+
+// RegisterTransformer makes fn available to the `transform` tag under name,
+// replacing any transformer already registered with that name.
+func RegisterTransformer(name string, fn TransformFunc) {
+	defaultRegistry.RegisterTransformer(name, fn)
+}
+
+func init() {
+	RegisterTransformer("trim", strings.TrimSpace)
+	RegisterTransformer("lower", strings.ToLower)
+	RegisterTransformer("upper", strings.ToUpper)
+}
+
+// parseTransformTag splits a `transform` tag into the names of its
+// transformers, applied in order.
+func parseTransformTag(tag string) []string {
+	var names []string
+	for _, name := range strings.Split(tag, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isStringTarget reports whether t holds strings a transformer can rewrite:
+// a string, or a pointer to or list of them.
+func isStringTarget(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.String
+}
+
+func checkTransformConfig(r *Registry, f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("transform")
+	if !ok {
+		return ""
+	}
+	if !isStringTarget(f.Type) {
+		return fmt.Sprintf("transform is only supported on string fields, got %s", f.Type)
+	}
+	for _, name := range parseTransformTag(tag) {
+		if _, ok := r.transformer(name); !ok {
+			return fmt.Sprintf("unknown transformer '%s'", name)
+		}
+	}
+	return ""
+}
+
+// transform applies the `transform` tags of v and the structs it holds.
+// It runs on the decoded payload, before the other checks. Unknown
+// transformers are skipped here and reported by the type configuration
+// check.
+func (g *Validate) transform(v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Kind() == reflect.Struct && !isValueType(v.Type()) && !isTime(v):
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := structField(t, i)
+			if f.PkgPath != "" {
+				continue
+			}
+			if tag, ok := f.Tag.Lookup("transform"); ok {
+				g.transformStrings(v.Field(i), parseTransformTag(tag))
+				continue
+			}
+			g.transform(v.Field(i))
+		}
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			g.transform(v.Index(i))
+		}
+	}
+}
+
+func (g *Validate) transformStrings(v reflect.Value, names []string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			g.transformStrings(v.Elem(), names)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			g.transformStrings(v.Index(i), names)
+		}
+	case reflect.String:
+		if !v.CanSet() {
+			return
+		}
+		s := v.String()
+		for _, name := range names {
+			if fn, ok := g.registry().transformer(name); ok {
+				s = fn(s)
+			}
+		}
+		v.SetString(s)
+	}
+}
@@ -0,0 +1,116 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// configIssue describes a struct tag that can never be applied, such as a
+// `format` that isn't registered.
+type configIssue struct {
+	field   reflect.StructField
+	message string
+}
+
+func (c *configIssue) error(tree string) error {
+	path := fieldName(c.field, tree)
+	return &Error{
+		ErrType: "INVALID_CONFIG_ERR",
+		Path:    path,
+		Message: fmt.Sprintf("Invalid configuration on field <%s> (%s): %s", path, c.field.Name, c.message),
+	}
+}
+
+// fieldConfigChecks inspect the tags of a single field and return a
+// description of the problem, or "" when the field is configured correctly.
+var fieldConfigChecks = []func(f reflect.StructField) string{
+	checkGodanticConfig,
+	checkPhoneConfig,
+	checkTimeConfig,
+	checkValueConfig,
+	checkMoneyConfig,
+	checkUnsignedConfig,
+	checkUniqueConfig,
+}
+
+// registryConfigChecks are the checks whose outcome depends on what is
+// registered, such as formats and custom validators.
+var registryConfigChecks = []func(r *Registry, f reflect.StructField) string{
+	checkFormatConfig,
+	checkCustomConfig,
+	checkTransformConfig,
+}
+
+func fieldConfigMessage(r *Registry, f reflect.StructField) string {
+	for _, check := range fieldConfigChecks {
+		if message := check(f); message != "" {
+			return message
+		}
+	}
+	for _, check := range registryConfigChecks {
+		if message := check(r, f); message != "" {
+			return message
+		}
+	}
+	return ""
+}
+
+type typeConfigKey struct {
+	registry *Registry
+	t        reflect.Type
+}
+
+type cachedConfig struct {
+	generation uint64
+	issue      *configIssue
+}
+
+// typeCache caches the outcome of typeConfigIssue per registry and struct
+// type, so tags are only checked the first time a type is inspected. Each
+// Validator has its own; other validators share typeConfigs.
+type typeCache struct {
+	entries sync.Map
+}
+
+var (
+	typeConfigs typeCache
+	// typeConfigGeneration outdates every cached outcome, and the fields
+	// cached by structField, when it changes; registrations can turn an
+	// unknown name into a valid one, and For changes the rules of a type.
+	typeConfigGeneration uint64
+)
+
+func (c *typeCache) issue(r *Registry, t reflect.Type) *configIssue {
+	key := typeConfigKey{registry: r, t: t}
+	generation := atomic.LoadUint64(&typeConfigGeneration)
+	if cached, ok := c.entries.Load(key); ok && cached.(cachedConfig).generation == generation {
+		return cached.(cachedConfig).issue
+	}
+	var issue *configIssue
+	for i := 0; i < t.NumField() && issue == nil; i++ {
+		f := structField(t, i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if message := fieldConfigMessage(r, f); message != "" {
+			issue = &configIssue{field: f, message: message}
+		}
+	}
+	c.entries.Store(key, cachedConfig{generation: generation, issue: issue})
+	return issue
+}
+
+func (g *Validate) typeConfigIssue(t reflect.Type) *configIssue {
+	cache := g.configs
+	if cache == nil {
+		cache = &typeConfigs
+	}
+	return cache.issue(g.registry(), t)
+}
+
+// resetTypeConfigs outdates every cached outcome.
+func resetTypeConfigs() {
+	atomic.AddUint64(&typeConfigGeneration, 1)
+}
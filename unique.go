@@ -0,0 +1,141 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// The `unique` tag rejects lists with repeated items. `unique:"true"`
+// compares whole items; on a list of structs, `unique:"sku"` compares the
+// given JSON keys, and `unique:"sku,warehouse"` their combination. Items
+// whose keys are null are never duplicates.
+
+func uniqueKeys(tag string) []string {
+	if tag == "true" {
+		return nil
+	}
+	var keys []string
+	for _, key := range strings.Split(tag, ",") {
+		keys = append(keys, strings.TrimSpace(key))
+	}
+	return keys
+}
+
+// jsonFieldIndex returns the index of the field of struct type t that is
+// bound from the JSON key name.
+func jsonFieldIndex(t reflect.Type, name string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+		if jsonName == "" {
+			jsonName = f.Name
+		}
+		if jsonName == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// checkUniqueConfig reports `unique` tags on fields that aren't lists, and
+// keys that aren't fields of the list's structs.
+func checkUniqueConfig(f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("unique")
+	if !ok {
+		return ""
+	}
+	t := f.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return fmt.Sprintf("unique: can't be used on a field of type %s", f.Type)
+	}
+	keys := uniqueKeys(tag)
+	if keys == nil {
+		return ""
+	}
+	elem := t.Elem()
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return fmt.Sprintf("unique: keys can only be used on a list of structs, use unique:\"true\" for %s", f.Type)
+	}
+	for _, key := range keys {
+		if _, ok := jsonFieldIndex(elem, key); !ok {
+			return fmt.Sprintf("unique: %s has no field '%s'", elem, key)
+		}
+	}
+	return ""
+}
+
+// uniqueKey returns the identity item is compared by, or false if it has a
+// null key.
+func uniqueKey(item reflect.Value, keys []string) (string, bool) {
+	for item.Kind() == reflect.Ptr || item.Kind() == reflect.Interface {
+		if item.IsNil() {
+			return "", false
+		}
+		item = item.Elem()
+	}
+	values := []any{item.Interface()}
+	if keys != nil {
+		values = values[:0]
+		for _, key := range keys {
+			i, _ := jsonFieldIndex(item.Type(), key)
+			field := item.Field(i)
+			if (field.Kind() == reflect.Ptr || field.Kind() == reflect.Interface) && field.IsNil() {
+				return "", false
+			}
+			values = append(values, field.Interface())
+		}
+	}
+	identity, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Sprintf("%#v", values), true
+	}
+	return string(identity), true
+}
+
+func (g *Validate) checkUnique(f reflect.StructField, v reflect.Value, tree string) error {
+	tag := f.Tag.Get("unique")
+	if tag == "" || tag == "false" || checkUniqueConfig(f) != "" {
+		return nil
+	}
+	keys := uniqueKeys(tag)
+	seen := make(map[string]int, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		identity, ok := uniqueKey(v.Index(i), keys)
+		if !ok {
+			continue
+		}
+		first, duplicate := seen[identity]
+		if !duplicate {
+			seen[identity] = i
+			continue
+		}
+		var suffix string
+		switch {
+		case len(keys) == 1:
+			suffix = "." + keys[0]
+		case len(keys) > 1:
+			suffix = ".{" + strings.Join(keys, ",") + "}"
+		}
+		path := fmt.Sprintf("%s[%d]", tree, i)
+		if len(keys) == 1 {
+			path += suffix
+		}
+		return &Error{
+			ErrType: "DUPLICATE_ITEM_ERR",
+			Path:    path,
+			Message: fmt.Sprintf("%s[%d]%s duplicates %s[%d]%s", tree, i, suffix, tree, first, suffix),
+		}
+	}
+	return nil
+}
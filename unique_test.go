@@ -0,0 +1,101 @@
+package godantic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueLists(t *testing.T) {
+	g := &Validate{}
+
+	type Item struct {
+		SKU       *string `json:"sku"`
+		Warehouse *string `json:"warehouse"`
+		Quantity  *int    `json:"quantity"`
+	}
+	type Order struct {
+		Items   *[]Item   `json:"items" unique:"sku"`
+		Stock   *[]Item   `json:"stock" unique:"sku,warehouse"`
+		MSISDNs *[]string `json:"msisdns" unique:"true"`
+		Tags    []string  `json:"tags" unique:"true"`
+	}
+
+	validCases := []string{
+		`{"items": [{"sku": "A"}, {"sku": "B"}, {"quantity": 1}, {"quantity": 2}]}`,
+		`{"stock": [{"sku": "A", "warehouse": "MPM"}, {"sku": "A", "warehouse": "BEW"}]}`,
+		`{"msisdns": ["841234567", "821234567"], "tags": ["a", "b"]}`,
+	}
+	for _, data := range validCases {
+		var o Order
+		assert.NoError(t, g.BindJSON([]byte(data), &o), data)
+	}
+
+	testCases := []struct {
+		name    string
+		data    string
+		path    string
+		message string
+	}{
+		{
+			"Duplicate key",
+			`{"items": [{"sku": "A"}, {"sku": "B"}, {"sku": "C"}, {"sku": "B", "quantity": 2}]}`,
+			"items[3].sku", "items[3].sku duplicates items[1].sku",
+		},
+		{
+			"Duplicate key combination",
+			`{"stock": [{"sku": "A", "warehouse": "MPM"}, {"sku": "A", "warehouse": "MPM"}]}`,
+			"stock[1]", "stock[1].{sku,warehouse} duplicates stock[0].{sku,warehouse}",
+		},
+		{
+			"Duplicate primitive",
+			`{"msisdns": ["841234567", "821234567", "841234567"]}`,
+			"msisdns[2]", "msisdns[2] duplicates msisdns[0]",
+		},
+		{
+			"Duplicate in a non-pointer list",
+			`{"tags": ["a", "a"]}`,
+			"tags[1]", "tags[1] duplicates tags[0]",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var o Order
+			err := g.BindJSON([]byte(tc.data), &o)
+			assert.Error(t, err)
+			assert.Equal(t, "DUPLICATE_ITEM_ERR", err.(*Error).ErrType)
+			assert.Equal(t, tc.path, err.(*Error).Path)
+			assert.Equal(t, tc.message, err.(*Error).Message)
+		})
+	}
+}
+
+func TestUniqueConfig(t *testing.T) {
+	g := &Validate{}
+
+	type Item struct {
+		SKU *string `json:"sku"`
+	}
+	testCases := []struct {
+		name  string
+		value any
+	}{
+		{"Unknown key", struct {
+			Items *[]Item `json:"items" unique:"code"`
+		}{}},
+		{"Keys on primitives", struct {
+			Items *[]string `json:"items" unique:"sku"`
+		}{}},
+		{"Not a list", struct {
+			Items *string `json:"items" unique:"true"`
+		}{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := g.InspectStruct(tc.value)
+			assert.Error(t, err)
+			assert.Equal(t, "INVALID_CONFIG_ERR", err.(*Error).ErrType)
+			assert.Equal(t, "items", err.(*Error).Path)
+		})
+	}
+}
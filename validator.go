@@ -0,0 +1,246 @@
+package godantic
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Option configures a validator built with New or NewValidator.
+type Option func(*Validate)
+
+// ErrorMode selects how many errors a validator reports.
+type ErrorMode int
+
+const (
+	// FirstError stops at the first error, which is returned as an *Error.
+	FirstError ErrorMode = iota
+	// AllErrors checks every field and list item and returns the errors it
+	// finds as Errors.
+	AllErrors
+)
+
+// Errors holds the errors found by a validator in AllErrors mode, in the
+// order of the fields.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validator validates with a configuration fixed by New, so one Validator
+// can be shared by any number of goroutines. Use With to derive a variant.
+// It caches the checks of each struct type it has seen.
+//
+// Validate remains available, and its zero value validates with the
+// defaults.
+type Validator struct {
+	config Validate
+}
+
+// New returns a Validator configured by opts.
+func New(opts ...Option) *Validator {
+	v := &Validator{}
+	v.config.configs = &typeCache{}
+	for _, opt := range opts {
+		opt(&v.config)
+	}
+	return v
+}
+
+// With returns a copy of v with opts applied on top of its configuration.
+// v itself is left unchanged.
+func (v *Validator) With(opts ...Option) *Validator {
+	derived := &Validator{config: v.config}
+	for _, opt := range opts {
+		opt(&derived.config)
+	}
+	return derived
+}
+
+// validate returns a Validate holding the configuration, so per-call state
+// never touches the shared Validator.
+func (v *Validator) validate() *Validate {
+	g := v.config
+	return &g
+}
+
+func (v *Validator) BindJSON(jsonData []byte, obj any) error {
+	return v.validate().BindJSON(jsonData, obj)
+}
+
+func (v *Validator) BindJSONPartial(jsonData []byte, obj any) error {
+	return v.validate().BindJSONPartial(jsonData, obj)
+}
+
+func (v *Validator) BindJSONPatch(patchData []byte, obj any) error {
+	return v.validate().BindJSONPatch(patchData, obj)
+}
+
+func (v *Validator) InspectStruct(val any) error {
+	return v.validate().InspectStruct(val)
+}
+
+func (v *Validator) ValidateMap(s *Schema, payload map[string]any) error {
+	return v.validate().ValidateMap(s, payload)
+}
+
+// NewValidator returns a Validate configured by opts.
+func NewValidator(opts ...Option) *Validate {
+	g := &Validate{}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// WithRegistry makes the validator use r instead of the default registry.
+func WithRegistry(r *Registry) Option {
+	return func(g *Validate) {
+		g.Registry = r
+	}
+}
+
+// IgnoreRequired skips `binding:"required"`.
+func IgnoreRequired() Option {
+	return func(g *Validate) {
+		g.IgnoreRequired = true
+	}
+}
+
+// IgnoreMinLen accepts empty strings and lists.
+func IgnoreMinLen() Option {
+	return func(g *Validate) {
+		g.IgnoreMinLen = true
+	}
+}
+
+// AllowUnknownFields accepts payload fields the struct doesn't declare.
+func AllowUnknownFields() Option {
+	return func(g *Validate) {
+		g.AllowUnknownFields = true
+	}
+}
+
+// WithLocale selects the messages registered for locale with
+// RegisterLocaleMessage.
+func WithLocale(locale string) Option {
+	return func(g *Validate) {
+		g.locale = locale
+	}
+}
+
+// WithMaxBytes rejects JSON payloads larger than n bytes, 0 for no limit.
+func WithMaxBytes(n int) Option {
+	return func(g *Validate) {
+		g.maxBytes = n
+	}
+}
+
+// WithMaxDepth rejects JSON payloads nesting objects and lists deeper than
+// n levels, 0 for no limit.
+func WithMaxDepth(n int) Option {
+	return func(g *Validate) {
+		g.maxDepth = n
+	}
+}
+
+// WithErrorMode selects how many errors are reported, FirstError by
+// default.
+func WithErrorMode(mode ErrorMode) Option {
+	return func(g *Validate) {
+		g.errorMode = mode
+	}
+}
+
+// checkLimits applies the limits of WithMaxBytes and WithMaxDepth to data.
+func (g *Validate) checkLimits(data []byte) error {
+	if g.maxBytes > 0 && len(data) > g.maxBytes {
+		return &Error{
+			ErrType: "PAYLOAD_TOO_LARGE_ERR",
+			Message: fmt.Sprintf("The given data is %d bytes, at most %d are allowed", len(data), g.maxBytes),
+		}
+	}
+	if g.maxDepth > 0 {
+		depth, inString, escaped := 0, false, false
+		for _, c := range data {
+			switch {
+			case escaped:
+				escaped = false
+			case inString && c == '\\':
+				escaped = true
+			case c == '"':
+				inString = !inString
+			case inString:
+			case c == '{' || c == '[':
+				if depth++; depth > g.maxDepth {
+					return &Error{
+						ErrType: "MAX_DEPTH_ERR",
+						Message: fmt.Sprintf("The given data is nested deeper than %d levels", g.maxDepth),
+					}
+				}
+			case c == '}' || c == ']':
+				depth--
+			}
+		}
+	}
+	return nil
+}
+
+// collect adds err to errs in AllErrors mode and reports whether validation
+// should go on.
+func (g *Validate) collect(errs *Errors, err error) bool {
+	if g.errorMode != AllErrors {
+		return false
+	}
+	switch e := err.(type) {
+	case *Error:
+		*errs = append(*errs, e)
+	case Errors:
+		*errs = append(*errs, e...)
+	default:
+		return false
+	}
+	return true
+}
+
+// result turns the errors collected in AllErrors mode into an error.
+func (errs Errors) result() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// publicError shapes the error returned to callers: registered messages
+// replace the built-in ones, and AllErrors mode always returns Errors.
+func (g *Validate) publicError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var errs Errors
+	switch e := err.(type) {
+	case *Error:
+		if e == nil {
+			return nil
+		}
+		errs = Errors{e}
+	case Errors:
+		errs = e
+	default:
+		return err
+	}
+	r := g.registry()
+	for _, e := range errs {
+		if fn, ok := r.message(g.locale, e.ErrType); ok {
+			e.Message = fn(e)
+		}
+	}
+	if g.errorMode == AllErrors {
+		return errs
+	}
+	return errs[0]
+}
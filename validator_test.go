@@ -0,0 +1,104 @@
+package godantic
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type vItem struct {
+	Name *string `json:"name" binding:"required"`
+}
+
+type vOrder struct {
+	ID    *string  `json:"id" binding:"required"`
+	Email *string  `json:"email" format:"email"`
+	Qty   *int     `json:"qty" ge:"1"`
+	Items *[]vItem `json:"items"`
+}
+
+func TestValidator(t *testing.T) {
+	v := New()
+	var order vOrder
+	assert.NoError(t, v.BindJSON([]byte(`{"id":"1","email":"a@b.co","qty":2}`), &order))
+	assert.Equal(t, "REQUIRED_FIELD_ERR", errType(v.BindJSON([]byte(`{"qty":2}`), &vOrder{})))
+
+	t.Run("Options", func(t *testing.T) {
+		assert.NoError(t, New(IgnoreRequired()).BindJSON([]byte(`{"qty":2}`), &vOrder{}))
+		assert.NoError(t, New(AllowUnknownFields()).InspectStruct(vOrder{ID: toPtr("1")}))
+		assert.NoError(t, New(IgnoreMinLen()).InspectStruct(vOrder{ID: toPtr("1"), Items: &[]vItem{}}))
+		assert.Equal(t, "EMPTY_LIST_ERR", errType(v.InspectStruct(vOrder{ID: toPtr("1"), Items: &[]vItem{}})))
+	})
+
+	t.Run("With", func(t *testing.T) {
+		lenient := v.With(IgnoreRequired())
+		assert.NoError(t, lenient.BindJSON([]byte(`{"qty":2}`), &vOrder{}))
+		assert.Error(t, v.BindJSON([]byte(`{"qty":2}`), &vOrder{}))
+	})
+
+	t.Run("Zero value Validate", func(t *testing.T) {
+		var g Validate
+		assert.Equal(t, "REQUIRED_FIELD_ERR", errType(g.BindJSON([]byte(`{"qty":2}`), &vOrder{})))
+	})
+
+	t.Run("All errors", func(t *testing.T) {
+		all := New(WithErrorMode(AllErrors))
+		err := all.BindJSON([]byte(`{"email":"nope","qty":0,"items":[{"name":"a"},{}]}`), &vOrder{})
+		errs, ok := err.(Errors)
+		if assert.True(t, ok, "%T", err) {
+			var paths []string
+			for _, e := range errs {
+				paths = append(paths, e.Path)
+			}
+			assert.Equal(t, []string{"id", "email", "qty", "items.name"}, paths)
+		}
+
+		err = all.BindJSON([]byte(`{"id":"1"}`), &vOrder{})
+		assert.NoError(t, err)
+		_, ok = all.BindJSON([]byte(`{"id":`), &vOrder{}).(Errors)
+		assert.True(t, ok)
+	})
+
+	t.Run("Limits", func(t *testing.T) {
+		assert.Equal(t, "PAYLOAD_TOO_LARGE_ERR", errType(New(WithMaxBytes(10)).BindJSON([]byte(`{"id":"12345678"}`), &vOrder{})))
+		deep := New(WithMaxDepth(3))
+		assert.NoError(t, deep.BindJSON([]byte(`{"id":"[[[","items":[{"name":"a"}]}`), &vOrder{}))
+		assert.Equal(t, "MAX_DEPTH_ERR", errType(deep.BindJSON([]byte(`{"id":"1","items":[{"name":{"x":[1]}}]}`), &vOrder{})))
+	})
+
+	t.Run("Locale", func(t *testing.T) {
+		r := NewRegistry(DefaultRegistry())
+		r.RegisterMessage("REQUIRED_FIELD_ERR", func(e *Error) string { return e.Path + " is required" })
+		r.RegisterLocaleMessage("pt", "REQUIRED_FIELD_ERR", func(e *Error) string { return e.Path + " é obrigatório" })
+		en := New(WithRegistry(r))
+		assert.EqualError(t, en.BindJSON([]byte(`{"qty":2}`), &vOrder{}), "id is required")
+		assert.EqualError(t, en.With(WithLocale("pt")).BindJSON([]byte(`{"qty":2}`), &vOrder{}), "id é obrigatório")
+		assert.EqualError(t, en.With(WithLocale("fr")).BindJSON([]byte(`{"qty":2}`), &vOrder{}), "id is required")
+	})
+
+	t.Run("Concurrent use", func(t *testing.T) {
+		var wg sync.WaitGroup
+		failures := make(chan error, 50)
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				payload := `{"id":"1","items":[{"name":"a"}]}`
+				if i%2 == 1 {
+					payload = strings.Replace(payload, `"name":"a"`, ``, 1)
+				}
+				err := v.BindJSON([]byte(payload), &vOrder{})
+				if (i%2 == 1) != (err != nil) {
+					failures <- err
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(failures)
+		for err := range failures {
+			t.Errorf("unexpected result: %v", err)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Value types are bound from a single JSON value and validated as a whole,
+// rather than as structs or plain integers: the civil types in civil.go,
+// Decimal and Money. Their range tags take literals of the type, e.g.
+// `min:"15m"` on a Duration or `le:"999.99"` on a Decimal. Money has its own
+// amount tags, see checkMoney.
+
+func isValueType(t reflect.Type) bool {
+	return t == dateType || t == timeOfDayType || t == durationType || t == decimalType || t == moneyType
+}
+
+func isValue(value reflect.Value) bool {
+	return isValueType(value.Type())
+}
+
+// parseValue parses s as a value of the value type t, as written in JSON
+// and in the range tags.
+func parseValue(t reflect.Type, s string) (any, error) {
+	switch t {
+	case dateType:
+		return ParseDate(s)
+	case timeOfDayType:
+		return ParseTimeOfDay(s)
+	case durationType:
+		d, err := time.ParseDuration(s)
+		return Duration(d), err
+	case decimalType:
+		return ParseDecimal(s)
+	}
+	return nil, fmt.Errorf("%s is not a value type", t)
+}
+
+// compareValues returns -1, 0 or 1 as a is before, equal to or after b.
+func compareValues(a, b any) int {
+	var before, after bool
+	switch a := a.(type) {
+	case Date:
+		before, after = a.Before(b.(Date)), a.After(b.(Date))
+	case TimeOfDay:
+		before, after = a.Before(b.(TimeOfDay)), a.After(b.(TimeOfDay))
+	case Duration:
+		before, after = a < b.(Duration), a > b.(Duration)
+	case Decimal:
+		return a.Cmp(b.(Decimal))
+	}
+	switch {
+	case before:
+		return -1
+	case after:
+		return 1
+	}
+	return 0
+}
+
+type boundTag struct {
+	tag     string
+	allowed func(cmp int) bool
+	errType string
+	message string
+}
+
+// rangeTags and comparisonTags map the bound tags to the comparison results
+// they allow and the error reported otherwise.
+var (
+	rangeTags = []boundTag{
+		{"min", func(cmp int) bool { return cmp >= 0 }, "MIN_VALUE_ERR", "must be at least"},
+		{"max", func(cmp int) bool { return cmp <= 0 }, "MAX_VALUE_ERR", "must be at most"},
+	}
+	comparisonTags = []boundTag{
+		{"gt", func(cmp int) bool { return cmp > 0 }, "GREATER_THAN_ERR", "must be greater than"},
+		{"ge", func(cmp int) bool { return cmp >= 0 }, "GREATER_EQUAL_ERR", "must be greater than or equal to"},
+		{"lt", func(cmp int) bool { return cmp < 0 }, "LESS_THAN_ERR", "must be less than"},
+		{"le", func(cmp int) bool { return cmp <= 0 }, "LESS_EQUAL_ERR", "must be less than or equal to"},
+	}
+	boundTags = append(append([]boundTag{}, rangeTags...), comparisonTags...)
+)
+
+// checkBounds checks value, of value type t, against the given bound tags.
+// Tags that aren't literals of t are reported by checkValueConfig.
+func checkBounds(f reflect.StructField, value any, t reflect.Type, tree string, tags []boundTag) error {
+	for _, b := range tags {
+		tag := f.Tag.Get(b.tag)
+		if tag == "" {
+			continue
+		}
+		bound, err := parseValue(t, tag)
+		if err == nil && !b.allowed(compareValues(value, bound)) {
+			return &Error{
+				ErrType: b.errType,
+				Path:    fieldName(f, tree),
+				Message: fmt.Sprintf("The field <%s> %s %s, but was %s", fieldName(f, tree), b.message, bound, value),
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Validate) checkValueBounds(f reflect.StructField, v reflect.Value, tree string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !isValue(v) {
+		return nil
+	}
+	if err := checkBounds(f, v.Interface(), v.Type(), tree, boundTags); err != nil {
+		return err
+	}
+	if d, ok := v.Interface().(Decimal); ok {
+		return g.checkMultipleOf(f, d, tree)
+	}
+	return nil
+}
+
+// checkValueConfig reports bound tags on value types that aren't literals
+// of the field's type, e.g. `min:"yesterday"` on a Date.
+func checkValueConfig(f reflect.StructField) string {
+	t := f.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !isValueType(t) {
+		return ""
+	}
+	tags := boundTags
+	if t == decimalType {
+		tags = append(tags[:len(tags):len(tags)], boundTag{tag: "multiple_of"})
+	}
+	for _, b := range tags {
+		if tag, ok := f.Tag.Lookup(b.tag); ok {
+			if _, err := parseValue(t, tag); err != nil {
+				return fmt.Sprintf("%s: '%s' is not a valid %s", b.tag, tag, t.Name())
+			}
+		}
+	}
+	return ""
+}
+
+// validValue reports whether a decoded JSON value is a valid value of t.
+// Decimals are also accepted as JSON numbers, and Money as an object.
+func validValue(t reflect.Type, raw any) bool {
+	if t == moneyType {
+		return validMoney(raw)
+	}
+	s, ok := raw.(string)
+	if n, isNumber := raw.(json.Number); isNumber && t == decimalType {
+		s, ok = n.String(), true
+	}
+	if !ok {
+		return false
+	}
+	_, err := parseValue(t, s)
+	return err == nil
+}
+
+func invalidValueError(t reflect.Type, raw any, path string) error {
+	var errType, expected string
+	switch t {
+	case dateType:
+		errType, expected = "INVALID_DATE_ERR", "a date in the form YYYY-MM-DD"
+	case timeOfDayType:
+		errType, expected = "INVALID_TIME_OF_DAY_ERR", "a time of day in the form HH:MM or HH:MM:SS"
+	case decimalType:
+		errType, expected = "INVALID_DECIMAL_ERR", "a decimal number"
+	case moneyType:
+		errType, expected = "INVALID_MONEY_ERR", `an amount and a currency, e.g. "10.50 MZN"`
+	default:
+		errType, expected = "INVALID_DURATION_ERR", "a duration such as 1h30m"
+	}
+	return &Error{
+		ErrType: errType,
+		Path:    path,
+		Message: fmt.Sprintf("The field <%s> has an invalid value '%v', expected %s", path, raw, expected),
+	}
+}